@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/VinayBhutange/hangman-go/game"
+	"github.com/VinayBhutange/hangman-go/i18n"
+	"github.com/VinayBhutange/hangman-go/utils"
+)
+
+// previewMaxWrong is the number of wrong guesses art packs are previewed with
+const previewMaxWrong = 6
+
+// applyAppearance switches to the art pack and color theme saved in the config,
+// keeping the defaults if they are no longer available, and turns on screen
+// reader mode and ASCII charts when the config or the flags ask for them
+func applyAppearance(config *game.Config) {
+	game.UseAccessibleMode(*accessFlag || config.Accessible)
+	game.UseASCIICharts(*asciiFlag || config.ASCIICharts || game.DetectASCIICharts(os.Getenv))
+	if err := game.UseArtPack(config.ArtPack); err != nil {
+		log.Printf("Warning: Could not use art pack %q: %v", config.ArtPack, err)
+	}
+	if err := utils.SetTheme(config.Theme); err != nil {
+		log.Printf("Warning: Could not use color theme %q: %v", config.Theme, err)
+	}
+}
+
+// showAppearanceMenu lets the player preview and pick the art pack and color
+// theme, and switch screen reader mode
+func showAppearanceMenu(config *game.Config) {
+	for {
+		fmt.Println(utils.Bold(i18n.T("appearance.title")))
+		fmt.Println("=============")
+		fmt.Println("1. " + i18n.T("appearance.art", game.ActiveArtPack().Name))
+		fmt.Println("2. " + i18n.T("appearance.theme", utils.CurrentTheme().Name))
+		fmt.Println("3. " + i18n.T("appearance.accessible", onOff(game.AccessibleMode())))
+		fmt.Println("4. " + i18n.T("menu.settings.back"))
+		fmt.Println()
+
+		choice, err := utils.GetUserInput(i18n.T("menu.choice", 1, 4))
+		if err != nil {
+			return
+		}
+
+		switch strings.TrimSpace(choice) {
+		case "1":
+			chooseArtPack(config)
+		case "2":
+			chooseTheme(config)
+		case "3":
+			toggleAccessible(config)
+		case "4", "":
+			return
+		default:
+			fmt.Println(utils.Error(i18n.T("menu.invalid_choice")))
+		}
+		fmt.Println()
+	}
+}
+
+// chooseArtPack lists the art packs, previews the one picked and switches to
+// it once confirmed
+func chooseArtPack(config *game.Config) {
+	packs, errs := game.ArtPacks()
+	for _, err := range errs {
+		fmt.Println(utils.Warning(i18n.T("appearance.art_error", err)))
+	}
+	for i, pack := range packs {
+		fmt.Printf("%d. %-16s %s\n", i+1, pack.Name, i18n.N("appearance.stages", len(pack.Stages), len(pack.Stages)))
+	}
+	fmt.Println()
+
+	index, ok := pickIndex(i18n.T("appearance.art_prompt", game.ActiveArtPack().Name), len(packs))
+	if !ok {
+		return
+	}
+	pack := packs[index]
+
+	fmt.Println()
+	game.Stdout().ArtPreview(pack, previewMaxWrong)
+	use, err := utils.GetYesNoInput(i18n.T("appearance.art_confirm", pack.Name))
+	if err != nil || !use {
+		return
+	}
+
+	_ = game.UseArtPack(pack.Name) //nolint:errcheck // The pack was just listed
+	config.ArtPack = pack.Name
+	saveConfig(config)
+	fmt.Println(utils.Success(i18n.T("appearance.art_changed", pack.Name)))
+}
+
+// chooseTheme lists the color themes with a sample of each and switches to the one picked
+func chooseTheme(config *game.Config) {
+	renderer := game.Stdout()
+	themes := utils.Themes()
+	for i, name := range themes {
+		theme, _ := utils.FindTheme(name) //nolint:errcheck // Names come from the built-in list
+		fmt.Printf("%d. %-12s ", i+1, name)
+		renderer.ThemePreview(theme)
+	}
+	fmt.Println()
+
+	index, ok := pickIndex(i18n.T("appearance.theme_prompt", utils.CurrentTheme().Name), len(themes))
+	if !ok {
+		return
+	}
+
+	_ = utils.SetTheme(themes[index]) //nolint:errcheck // Names come from the built-in list
+	config.Theme = themes[index]
+	saveConfig(config)
+	fmt.Println(utils.Success(i18n.T("appearance.theme_changed", themes[index])))
+}
+
+// toggleAccessible switches screen reader mode and saves the choice
+func toggleAccessible(config *game.Config) {
+	config.Accessible = !game.AccessibleMode()
+	game.UseAccessibleMode(config.Accessible)
+	saveConfig(config)
+	fmt.Println(utils.Success(i18n.T("appearance.accessible_changed", onOff(config.Accessible))))
+}
+
+// pickIndex asks for a number between 1 and count and returns it as an index
+func pickIndex(prompt string, count int) (int, bool) {
+	input, err := utils.GetUserInput(prompt)
+	if err != nil || input == "" {
+		return 0, false
+	}
+
+	number, err := strconv.Atoi(input)
+	if err != nil || number < 1 || number > count {
+		fmt.Println(utils.Error(i18n.T("menu.invalid_choice")))
+		return 0, false
+	}
+	return number - 1, true
+}
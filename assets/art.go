@@ -0,0 +1,8 @@
+package assets
+
+import "embed"
+
+// ArtPacks holds the built-in hangman art packs, one art/<name>.txt per pack
+//
+//go:embed art/*.txt
+var ArtPacks embed.FS
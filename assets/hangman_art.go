@@ -1,74 +1,18 @@
 // Package assets contains game art and visual elements for the hangman game.
 package assets
 
-// HangmanStages contains all the ASCII art stages for the hangman
-var HangmanStages = []string{
-	// Stage 0: Empty gallows
-	`
-   +---+
-   |   |
-       |
-       |
-       |
-       |
-=========`,
-	// Stage 1: Head
-	`
-   +---+
-   |   |
-   O   |
-       |
-       |
-       |
-=========`,
-	// Stage 2: Body
-	`
-   +---+
-   |   |
-   O   |
-   |   |
-       |
-       |
-=========`,
-	// Stage 3: Left arm
-	`
-   +---+
-   |   |
-   O   |
-  /|   |
-       |
-       |
-=========`,
-	// Stage 4: Right arm
-	`
-   +---+
-   |   |
-   O   |
-  /|\  |
-       |
-       |
-=========`,
-	// Stage 5: Left leg
-	`
-   +---+
-   |   |
-   O   |
-  /|\  |
-  /    |
-       |
-=========`,
-	// Stage 6: Right leg (game over)
-	`
-   +---+
-   |   |
-   O   |
-  /|\  |
-  / \  |
-       |
-=========`,
-}
+import "strings"
+
+// HangmanStages contains all the ASCII art stages for the hangman, from the
+// classic art pack
+//
+// Deprecated: Use game.FindArtPack or game.ActiveArtPack, which also offer the
+// other art packs. HangmanStages will be removed in the next release.
+var HangmanStages = classicStages()
 
-// GetHangmanStage returns the hangman ASCII art for a given stage
+// GetHangmanStage returns the ASCII art for a given stage
+//
+// Deprecated: Use the Stages of a game.ArtPack.
 func GetHangmanStage(stage int) string {
 	if stage < 0 || stage >= len(HangmanStages) {
 		return HangmanStages[0] // Return empty gallows for invalid stage
@@ -77,10 +21,37 @@ func GetHangmanStage(stage int) string {
 }
 
 // GetMaxStages returns the maximum number of hangman stages
+//
+// Deprecated: Use the Stages of a game.ArtPack.
 func GetMaxStages() int {
 	return len(HangmanStages) - 1 // Subtract 1 because we start from 0
 }
 
+// classicStages reads the stages of the built-in classic art pack in the form
+// HangmanStages always had: each stage starts on a new line
+func classicStages() []string {
+	data, err := ArtPacks.ReadFile("art/classic.txt")
+	if err != nil {
+		panic("classic art pack missing: " + err.Error())
+	}
+
+	var stages []string
+	var stage []string
+	for _, line := range strings.Split(strings.TrimRight(string(data), "\n"), "\n") {
+		line = strings.TrimRight(line, "\r")
+		switch {
+		case len(stages) == 0 && len(stage) == 0 && strings.HasPrefix(line, "#"):
+			continue
+		case line == "---":
+			stages = append(stages, "\n"+strings.Join(stage, "\n"))
+			stage = nil
+		default:
+			stage = append(stage, line)
+		}
+	}
+	return append(stages, "\n"+strings.Join(stage, "\n"))
+}
+
 // GameTitle returns ASCII art for the game title
 func GameTitle() string {
 	return `
@@ -0,0 +1,450 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/VinayBhutange/hangman-go/game"
+)
+
+const usage = `Usage: hangman [command]
+
+Run without a command to play the game.
+
+Commands:
+  words        Word file maintenance (lint, dedupe, stats, sort, import)
+  stats        Export statistics, or import them from another machine or profile
+  history      Export the game history
+  leaderboard  Rank profiles by rating, win rate and streak
+`
+
+const wordsUsage = `Usage: hangman words <command> [options] <file>
+
+Commands:
+  lint    Report duplicates, invalid entries, mixed case and blocked words
+  dedupe  Rewrite the file without duplicates or invalid entries
+  stats   Show length histogram and per-difficulty counts
+  sort    Rewrite the file normalized and sorted alphabetically
+  import  Build a word pack from a hunspell .dic/.aff pair or an aspell word dump
+          hangman words import [-aff file] [-expand] [-lang code] [-min n] [-max n] -o pack.txt <dictionary>
+`
+
+const statsUsage = `Usage: hangman [-profile name] stats <command> [options]
+
+Commands:
+  export  Write statistics and history as one JSON file, or the statistics as CSV
+          hangman stats export [-format json|csv] [-o file]
+  import  Merge a JSON export or another stats.json into the profile's statistics
+          hangman stats import <file>
+`
+
+const historyUsage = `Usage: hangman [-profile name] history export [-format json|csv] [-o file]
+
+Writes every recorded game, oldest first.
+`
+
+// runCommand dispatches command line subcommands and returns the process exit code
+func runCommand(args []string) int {
+	switch args[0] {
+	case "words":
+		return runWordsCommand(args[1:])
+	case "stats":
+		return runStatsCommand(args[1:])
+	case "history":
+		return runHistoryCommand(args[1:])
+	case "leaderboard":
+		return runLeaderboardCommand(args[1:])
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+		return 0
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command: %s\n\n%s", args[0], usage)
+		return 2
+	}
+}
+
+// runWordsCommand implements the word file maintenance commands
+func runWordsCommand(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, wordsUsage)
+		return 2
+	}
+
+	command := args[0]
+	if command == "import" {
+		return runWordsImport(args[1:])
+	}
+
+	flags := flag.NewFlagSet("words "+command, flag.ContinueOnError)
+	output := flags.String("o", "", "write the result to this file instead of rewriting the input")
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	if flags.NArg() != 1 {
+		fmt.Fprint(os.Stderr, wordsUsage)
+		return 2
+	}
+	filename := flags.Arg(0)
+
+	wordFile, err := game.ReadWordFile(filename)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	target := filename
+	if *output != "" {
+		target = *output
+	}
+
+	switch command {
+	case "lint":
+		// Lint checks against everything family-friendly mode would filter
+		blocklist, err := game.LoadUserBlocklist(true)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		report := wordFile.Analyze(blocklist)
+		printWordLint(report)
+		if report.HasIssues() {
+			return 1
+		}
+		return 0
+	case "stats":
+		printWordStats(wordFile.Analyze(nil))
+		return 0
+	case "dedupe":
+		return rewriteWords(target, wordFile, wordFile.Normalized())
+	case "sort":
+		return rewriteWords(target, wordFile, wordFile.Sorted())
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown words command: %s\n\n%s", command, wordsUsage)
+		return 2
+	}
+}
+
+// runWordsImport converts a hunspell or aspell dictionary into a word pack
+func runWordsImport(args []string) int {
+	defaults := game.DefaultImportOptions()
+	flags := flag.NewFlagSet("words import", flag.ContinueOnError)
+	output := flags.String("o", "", "word pack to write (required)")
+	affPath := flags.String("aff", "", "hunspell .aff file (default: next to the .dic file)")
+	expand := flags.Bool("expand", false, "generate affixed word forms instead of only stripping flags")
+	langCode := flags.String("lang", "", "language of the dictionary; sets the alphabet and adds a language header")
+	minLen := flags.Int("min", defaults.MinLength, "shortest word to keep")
+	maxLen := flags.Int("max", defaults.MaxLength, "longest word to keep")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if flags.NArg() != 1 || *output == "" {
+		fmt.Fprint(os.Stderr, wordsUsage)
+		return 2
+	}
+	dictionary := flags.Arg(0)
+
+	opts := game.ImportOptions{ExpandAffixes: *expand, MinLength: *minLen, MaxLength: *maxLen}
+	var header []string
+	if *langCode != "" {
+		lang, ok := game.GetLanguage(*langCode)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: unknown language %q\n", *langCode)
+			return 2
+		}
+		opts.Language = lang
+		header = []string{"# language: " + lang.Code}
+	}
+
+	var words []string
+	var err error
+	if strings.EqualFold(filepath.Ext(dictionary), ".dic") {
+		aff := *affPath
+		if aff == "" {
+			candidate := strings.TrimSuffix(dictionary, filepath.Ext(dictionary)) + ".aff"
+			if _, statErr := os.Stat(candidate); statErr == nil {
+				aff = candidate
+			}
+		}
+		words, err = game.ImportHunspell(dictionary, aff, opts)
+	} else {
+		words, err = game.ImportAspell(dictionary, opts)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	if len(words) == 0 {
+		fmt.Fprintln(os.Stderr, "Error: no usable words found in dictionary")
+		return 1
+	}
+
+	if err := game.WriteWordFile(*output, header, words); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("Imported %d words from %s into %s\n", len(words), dictionary, *output)
+	return 0
+}
+
+// runStatsCommand implements statistics export and import
+func runStatsCommand(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, statsUsage)
+		return 2
+	}
+
+	switch args[0] {
+	case "export":
+		format, output, ok := parseExportFlags("stats export", args[1:], statsUsage)
+		if !ok {
+			return 2
+		}
+		stats, err := game.LoadStatistics()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		history, err := game.LoadHistory()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		return writeExport(output, func(w io.Writer) error {
+			return game.ExportStatistics(w, format, stats, history)
+		})
+	case "import":
+		if len(args) != 2 {
+			fmt.Fprint(os.Stderr, statsUsage)
+			return 2
+		}
+		result, err := importStatistics(args[1])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		fmt.Printf("Imported %d games from %s (%d already recorded games skipped)\n", result.Games, args[1], result.Duplicates)
+		if result.Untracked > 0 {
+			fmt.Printf("Warning: %d games had no history entry and will be counted again if this file is imported again\n", result.Untracked)
+		}
+		return 0
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown stats command: %s\n\n%s", args[0], statsUsage)
+		return 2
+	}
+}
+
+// runHistoryCommand implements history export
+func runHistoryCommand(args []string) int {
+	if len(args) == 0 || args[0] != "export" {
+		fmt.Fprint(os.Stderr, historyUsage)
+		return 2
+	}
+
+	format, output, ok := parseExportFlags("history export", args[1:], historyUsage)
+	if !ok {
+		return 2
+	}
+	history, err := game.LoadHistory()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return writeExport(output, func(w io.Writer) error {
+		return game.ExportHistory(w, format, history)
+	})
+}
+
+// runLeaderboardCommand prints every profile ranked by rating, win rate and streak
+func runLeaderboardCommand(args []string) int {
+	if len(args) != 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return 2
+	}
+
+	entries, skipped, err := game.Leaderboard()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	for _, err := range skipped {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
+	fmt.Printf("%4s  %-20s %7s %6s %9s %7s %12s\n", "Rank", "Profile", "Rating", "Games", "Win Rate", "Streak", "Best Streak")
+	for i, entry := range entries {
+		fmt.Printf("%4d  %-20s %7.0f %6d %8.1f%% %7d %12d\n", i+1, entry.Profile,
+			entry.Rating.Rating, entry.Rating.Games, entry.WinRate, entry.CurrentStreak, entry.LongestStreak)
+	}
+	return 0
+}
+
+// parseExportFlags parses the -format and -o options of the export commands
+func parseExportFlags(name string, args []string, usage string) (string, string, bool) {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	format := flags.String("format", game.FormatJSON, "export format: json or csv")
+	output := flags.String("o", "", "file to write (default: standard output)")
+	if err := flags.Parse(args); err != nil {
+		return "", "", false
+	}
+	if flags.NArg() != 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return "", "", false
+	}
+	if *format != game.FormatJSON && *format != game.FormatCSV {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", game.ErrUnknownFormat)
+		return "", "", false
+	}
+	return *format, *output, true
+}
+
+// writeExport runs write against the output file, or standard output when there is none
+func writeExport(output string, write func(w io.Writer) error) int {
+	if output == "" {
+		if err := write(os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	if err := exportToFile(output, write); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	fmt.Printf("Exported to %s\n", output)
+	return 0
+}
+
+// exportToFile creates a file and runs write against it
+func exportToFile(path string, write func(w io.Writer) error) error {
+	//nolint:gosec // G304: File path is provided by the player
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create export file: %w", err)
+	}
+	if err := write(file); err != nil {
+		_ = file.Close() //nolint:errcheck // The write error is more useful
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close export file: %w", err)
+	}
+	return nil
+}
+
+// importStatistics merges an export or stats file into the active profile and saves the result
+func importStatistics(path string) (game.ImportResult, error) {
+	stats, err := game.LoadStatistics()
+	if err != nil {
+		return game.ImportResult{}, err
+	}
+	other, history, err := game.ReadImport(path)
+	if err != nil {
+		return game.ImportResult{}, err
+	}
+	result, err := stats.Import(other, history)
+	if err != nil {
+		return game.ImportResult{}, err
+	}
+	return result, stats.SaveStatistics()
+}
+
+// rewriteWords writes normalized words back to disk and reports what changed
+func rewriteWords(filename string, wordFile *game.WordFile, words []string) int {
+	if err := game.WriteWordFile(filename, wordFile.Header, words); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("Wrote %d words to %s (%d entries removed)\n", len(words), filename, len(wordFile.Entries)-len(words))
+	return 0
+}
+
+// printWordLint prints the problems found in a word file
+func printWordLint(report *game.WordReport) {
+	fmt.Printf("Entries: %d, unique valid words: %d\n", report.Total, report.Unique)
+
+	if len(report.Duplicates) > 0 {
+		fmt.Printf("\nDuplicates (%d):\n", len(report.Duplicates))
+		for _, word := range sortedKeys(report.Duplicates) {
+			fmt.Printf("  %s x%d\n", word, report.Duplicates[word])
+		}
+	}
+
+	if len(report.Invalid) > 0 {
+		fmt.Printf("\nInvalid entries (%d):\n", len(report.Invalid))
+		for _, entry := range report.Invalid {
+			fmt.Printf("  %q\n", entry)
+		}
+	}
+
+	if len(report.MixedCase) > 0 {
+		fmt.Printf("\nMixed case entries (%d):\n", len(report.MixedCase))
+		for _, entry := range report.MixedCase {
+			fmt.Printf("  %s\n", entry)
+		}
+	}
+
+	if len(report.Blocked) > 0 {
+		fmt.Printf("\nBlocked words (%d):\n", len(report.Blocked))
+		for _, word := range report.Blocked {
+			fmt.Printf("  %s\n", word)
+		}
+	}
+
+	if !report.HasIssues() {
+		fmt.Println("No issues found.")
+	}
+}
+
+// printWordStats prints the length histogram and difficulty counts of a word file
+func printWordStats(report *game.WordReport) {
+	fmt.Printf("Unique valid words: %d\n", report.Unique)
+
+	fmt.Println("\nWords by length:")
+	lengths := make([]int, 0, len(report.LengthHistogram))
+	for length := range report.LengthHistogram {
+		lengths = append(lengths, length)
+	}
+	sort.Ints(lengths)
+	for _, length := range lengths {
+		count := report.LengthHistogram[length]
+		fmt.Printf("  %2d: %4d %s\n", length, count, strings.Repeat("#", scaleBar(count, report.Unique, 40)))
+	}
+
+	fmt.Println("\nWords by difficulty:")
+	for _, difficulty := range []string{game.DifficultyEasy, game.DifficultyMedium, game.DifficultyHard, game.DifficultyUnrated} {
+		fmt.Printf("  %-8s %d\n", difficulty+":", report.DifficultyCounts[difficulty])
+	}
+}
+
+// scaleBar scales a count to a bar width relative to total
+func scaleBar(count, total, width int) int {
+	if total == 0 {
+		return 0
+	}
+	bar := count * width / total
+	if bar == 0 && count > 0 {
+		bar = 1
+	}
+	return bar
+}
+
+// sortedKeys returns the keys of a map in alphabetical order
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
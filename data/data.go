@@ -0,0 +1,37 @@
+// Package data embeds the word packs shipped with the game into the binary.
+package data
+
+import (
+	"embed"
+	"io/fs"
+	"sort"
+	"strings"
+)
+
+// DefaultPack is the name of the English word pack used when no other source is configured
+const DefaultPack = "words.txt"
+
+// Packs contains every word pack in this directory
+//
+//go:embed *.txt
+var Packs embed.FS
+
+// PackName returns the embedded pack for a language code. Packs other than
+// English are named words_<code>.txt.
+func PackName(code string) string {
+	if code == "" || code == "en" {
+		return DefaultPack
+	}
+	return "words_" + code + ".txt"
+}
+
+// PackLanguages returns the language codes that have an embedded pack
+func PackLanguages() []string {
+	codes := []string{"en"}
+	matches, _ := fs.Glob(Packs, "words_*.txt") //nolint:errcheck // The pattern is valid
+	for _, match := range matches {
+		codes = append(codes, strings.TrimSuffix(strings.TrimPrefix(match, "words_"), ".txt"))
+	}
+	sort.Strings(codes)
+	return codes
+}
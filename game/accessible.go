@@ -0,0 +1,185 @@
+package game
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VinayBhutange/hangman-go/i18n"
+	"github.com/VinayBhutange/hangman-go/utils"
+)
+
+// accessible selects descriptive text instead of art, emoji and screen clears
+var accessible bool
+
+// UseAccessibleMode switches screen reader mode on or off. The board and the
+// trends are described in words instead of drawn, messages leave out emoji and
+// the screen is never cleared.
+func UseAccessibleMode(on bool) {
+	accessible = on
+	i18n.UsePlainText(on)
+}
+
+// AccessibleMode reports whether screen reader mode is on
+func AccessibleMode() bool {
+	return accessible
+}
+
+// describeGame writes the state of a game as sentences: the wrong guesses, the
+// word's pattern, where the revealed letters are and which letters were tried
+func (r *Renderer) describeGame(g *Game) {
+	r.println(i18n.T("display.game_title"))
+	r.describeWrongGuesses(g.WrongGuesses, g.MaxWrongGuesses)
+
+	letters := []rune(g.Word)
+	pattern := make([]string, len(letters))
+	positions := make(map[rune][]string)
+	var order []rune
+	for i, letter := range letters {
+		if !g.GuessedLetters[letter] {
+			pattern[i] = i18n.T("access.blank")
+			continue
+		}
+		pattern[i] = string(letter)
+		if positions[letter] == nil {
+			order = append(order, letter)
+		}
+		positions[letter] = append(positions[letter], strconv.Itoa(i+1))
+	}
+	r.println(i18n.N("access.pattern", len(letters), len(letters), strings.Join(pattern, ", ")))
+
+	if len(order) == 0 {
+		r.println(i18n.T("access.revealed_none"))
+	} else {
+		revealed := make([]string, len(order))
+		for i, letter := range order {
+			revealed[i] = i18n.N("access.letter_at", len(positions[letter]), letter, strings.Join(positions[letter], ", "))
+		}
+		r.println(i18n.T("access.revealed", strings.Join(revealed, "; ")))
+	}
+
+	if wrongLetters := g.GetWrongLetters(); len(wrongLetters) > 0 {
+		r.println(i18n.T("display.wrong_letters", formatLetters(wrongLetters)))
+	}
+	r.describeKeyboard(g)
+	r.println()
+}
+
+// describeWrongGuesses writes how many wrong guesses are used and left
+func (r *Renderer) describeWrongGuesses(wrongGuesses, maxWrong int) {
+	left := maxWrong - wrongGuesses
+	if left < 0 {
+		left = 0
+	}
+	r.println(i18n.T("access.wrong_guesses", wrongGuesses, maxWrong, left))
+}
+
+// describeKeyboard lists the letters of the active alphabet not guessed yet
+func (r *Renderer) describeKeyboard(g *Game) {
+	var left []rune
+	for _, letter := range utils.Alphabet() {
+		if !g.GuessedLetters[letter] {
+			left = append(left, letter)
+		}
+	}
+	if len(left) > 0 {
+		r.println(i18n.T("access.letters_left", formatLetters(left)))
+	}
+}
+
+// describeTrends writes the trends screen as sentences: how the weekly results
+// changed, each week's results, the daily activity and the wrong guesses of
+// won games
+func (r *Renderer) describeTrends(history []HistoryEntry, weeks int, now time.Time) {
+	r.println(i18n.T("trends.title"))
+	if len(history) == 0 {
+		r.println(i18n.T("analytics.empty"))
+		r.println()
+		return
+	}
+
+	var played []Period
+	weekly := WeeklyTrends(history, weeks, now)
+	for _, week := range weekly {
+		if week.Games > 0 {
+			played = append(played, week)
+		}
+	}
+	if len(played) == 0 {
+		r.println(i18n.N("access.no_recent_games", weeks, weeks))
+	} else {
+		first, last := played[0], played[len(played)-1]
+		r.describeTrend(i18n.T("access.trend.games"), "%.0f", first, last, func(p Period) float64 {
+			return float64(p.Games)
+		})
+		r.describeTrend(i18n.T("access.trend.win_rate"), "%.0f%%", first, last, Period.WinRate)
+		r.describeTrend(i18n.T("access.trend.avg_wrong"), "%.1f", first, last, Period.AverageWrongGuesses)
+	}
+
+	r.println()
+	for _, week := range weekly {
+		start := week.Start.Format(dayFormat)
+		if week.Games == 0 {
+			r.println(i18n.T("access.week_empty", start))
+			continue
+		}
+		r.println(i18n.N("access.week", week.Games, start, week.Games, week.WinRate(), week.AverageWrongGuesses()))
+	}
+
+	r.println()
+	total, active := 0, 0
+	var busiest Period
+	for _, day := range DailyTrends(history, trendDays, now) {
+		total += day.Games
+		if day.Games > 0 {
+			active++
+		}
+		if day.Games > busiest.Games {
+			busiest = day
+		}
+	}
+	r.println(i18n.N("access.days", active, trendDays, total, active))
+	if busiest.Games > 0 {
+		r.println(i18n.T("access.busiest_day", busiest.Start.Format(dayFormat), busiest.Games))
+	}
+
+	var wins []string
+	for wrong, count := range WrongGuessDistribution(history) {
+		if count > 0 {
+			wins = append(wins, i18n.N("access.wins_with", count, count, wrong))
+		}
+	}
+	if len(wins) == 0 {
+		r.println(i18n.T("access.no_wins"))
+	} else {
+		r.println(i18n.T("access.distribution", strings.Join(wins, "; ")))
+	}
+	r.println()
+}
+
+// describeTrend writes whether a weekly value rose, fell or stayed the same
+// from the first week with games to the last, formatting it with format
+func (r *Renderer) describeTrend(name, format string, first, last Period, value func(Period) float64) {
+	from, to := fmt.Sprintf(format, value(first)), fmt.Sprintf(format, value(last))
+	firstWeek, lastWeek := first.Start.Format(dayFormat), last.Start.Format(dayFormat)
+	switch {
+	case firstWeek == lastWeek:
+		r.println(i18n.T("access.trend_single", name, to, lastWeek))
+	case from == to:
+		r.println(i18n.T("access.trend_steady", name, to, firstWeek, lastWeek))
+	case value(first) < value(last):
+		r.println(i18n.T("access.trend_rose", name, from, firstWeek, to, lastWeek))
+	default:
+		r.println(i18n.T("access.trend_fell", name, from, firstWeek, to, lastWeek))
+	}
+}
+
+// decorate puts an emoji prefix before a message, unless the renderer is in
+// screen reader mode
+func (r *Renderer) decorate(prefix, text string) string {
+	if r.accessible {
+		return text
+	}
+	return prefix + text
+}
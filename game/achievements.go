@@ -0,0 +1,233 @@
+package game
+
+import (
+	_ "embed" // Needed for the built-in achievement definitions
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+	"unicode/utf8"
+
+	"github.com/VinayBhutange/hangman-go/i18n"
+)
+
+// AchievementsFile is the name of the unlocked achievements file in the profile directory
+const AchievementsFile = "achievements.json"
+
+//go:embed achievements.json
+var builtinAchievements []byte
+
+// Achievement rules. Threshold and Difficulty parameterize them as noted.
+const (
+	RuleGamesPlayed   = "games_played"   // Threshold games played
+	RuleGamesWon      = "games_won"      // Threshold games won
+	RuleWinStreak     = "win_streak"     // Threshold wins in a row
+	RuleFlawlessWin   = "flawless_win"   // A win without a wrong guess
+	RuleLastGuessWin  = "last_guess_win" // A win with a single wrong guess to spare
+	RuleDifficultyWin = "difficulty_win" // A win at Difficulty
+	RuleLongWordWin   = "long_word_win"  // A win on a word of at least Threshold letters
+	RuleFastWin       = "fast_win"       // A win in at most Threshold seconds
+	RuleDailyStreak   = "daily_streak"   // Games played on Threshold days in a row
+)
+
+// dayFormat identifies calendar days for the daily streak
+const dayFormat = "2006-01-02"
+
+// Achievement defines something a player can unlock. Names and descriptions
+// come from the message catalog as achievement.<id> and achievement.<id>.description.
+type Achievement struct {
+	ID         string `json:"id"`
+	Icon       string `json:"icon"`
+	Rule       string `json:"rule"`
+	Threshold  int    `json:"threshold,omitempty"`
+	Difficulty string `json:"difficulty,omitempty"`
+}
+
+// Name returns the achievement's name in the interface language
+func (a Achievement) Name() string {
+	return i18n.T("achievement." + a.ID)
+}
+
+// Description returns what it takes to unlock the achievement
+func (a Achievement) Description() string {
+	if a.Threshold > 0 {
+		return i18n.N("achievement."+a.ID+".description", a.Threshold)
+	}
+	return i18n.T("achievement." + a.ID + ".description")
+}
+
+// ParseAchievements reads achievement definitions from JSON
+func ParseAchievements(data []byte) ([]Achievement, error) {
+	var definitions []Achievement
+	if err := json.Unmarshal(data, &definitions); err != nil {
+		return nil, fmt.Errorf("failed to parse achievements: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	for _, a := range definitions {
+		if a.ID == "" || seen[a.ID] {
+			return nil, fmt.Errorf("achievement IDs must be unique and not empty: %q", a.ID)
+		}
+		seen[a.ID] = true
+
+		switch a.Rule {
+		case RuleFlawlessWin, RuleLastGuessWin:
+		case RuleDifficultyWin:
+			if a.Difficulty == "" {
+				return nil, fmt.Errorf("achievement %s needs a difficulty", a.ID)
+			}
+		case RuleGamesPlayed, RuleGamesWon, RuleWinStreak, RuleLongWordWin, RuleFastWin, RuleDailyStreak:
+			if a.Threshold <= 0 {
+				return nil, fmt.Errorf("achievement %s needs a threshold", a.ID)
+			}
+		default:
+			return nil, fmt.Errorf("achievement %s has unknown rule %q", a.ID, a.Rule)
+		}
+	}
+	return definitions, nil
+}
+
+// Achievements returns the built-in achievement definitions
+func Achievements() []Achievement {
+	definitions, err := ParseAchievements(builtinAchievements)
+	if err != nil {
+		panic(err) // The embedded definitions are checked by the tests
+	}
+	return definitions
+}
+
+// AchievementState records a profile's unlocked achievements
+type AchievementState struct {
+	Unlocked  map[string]time.Time `json:"unlocked"`   // Achievement ID -> unlock date
+	DayStreak int                  `json:"day_streak"` // Consecutive days with at least one game
+	LastDay   string               `json:"last_day"`   // Last day a game was played, as YYYY-MM-DD
+
+	definitions []Achievement
+}
+
+// NewAchievementState creates an empty state for the given definitions
+func NewAchievementState(definitions []Achievement) *AchievementState {
+	return &AchievementState{
+		Unlocked:    make(map[string]time.Time),
+		definitions: definitions,
+	}
+}
+
+// LoadAchievements loads the active profile's unlocked achievements
+func LoadAchievements() (*AchievementState, error) {
+	state := NewAchievementState(Achievements())
+
+	//nolint:gosec // G304: File path is controlled by the application
+	data, err := os.ReadFile(getAchievementsFilePath())
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read achievements file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse achievements: %w", err)
+	}
+	if state.Unlocked == nil {
+		state.Unlocked = make(map[string]time.Time)
+	}
+	return state, nil
+}
+
+// Save saves the unlocked achievements to the active profile
+func (a *AchievementState) Save() error {
+	path := getAchievementsFilePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create achievements directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal achievements: %w", err)
+	}
+	if err := writeFileAtomic(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write achievements file: %w", err)
+	}
+	return nil
+}
+
+// Definitions returns every achievement that can be unlocked
+func (a *AchievementState) Definitions() []Achievement {
+	return a.definitions
+}
+
+// IsUnlocked reports whether an achievement has been unlocked, and when
+func (a *AchievementState) IsUnlocked(id string) (time.Time, bool) {
+	unlocked, ok := a.Unlocked[id]
+	return unlocked, ok
+}
+
+// Evaluate checks the achievements after a game has been recorded in stats and
+// returns the ones it unlocked
+func (a *AchievementState) Evaluate(stats *Statistics, entry HistoryEntry) []Achievement {
+	a.recordDay(entry.PlayedAt)
+
+	var unlocked []Achievement
+	for _, achievement := range a.definitions {
+		if _, ok := a.Unlocked[achievement.ID]; ok {
+			continue
+		}
+		if a.met(achievement, stats, entry) {
+			a.Unlocked[achievement.ID] = entry.PlayedAt
+			unlocked = append(unlocked, achievement)
+		}
+	}
+	return unlocked
+}
+
+// recordDay updates the streak of consecutive days played
+func (a *AchievementState) recordDay(playedAt time.Time) {
+	day := playedAt.Local().Format(dayFormat)
+	switch {
+	case day == a.LastDay:
+		return
+	case playedAt.Local().AddDate(0, 0, -1).Format(dayFormat) == a.LastDay:
+		a.DayStreak++
+	default:
+		a.DayStreak = 1
+	}
+	a.LastDay = day
+}
+
+// met reports whether a game unlocks an achievement
+func (a *AchievementState) met(achievement Achievement, stats *Statistics, entry HistoryEntry) bool {
+	won := entry.Won()
+	switch achievement.Rule {
+	case RuleGamesPlayed:
+		return stats.GamesPlayed >= achievement.Threshold
+	case RuleGamesWon:
+		return stats.GamesWon >= achievement.Threshold
+	case RuleWinStreak:
+		return stats.CurrentStreak >= achievement.Threshold
+	case RuleFlawlessWin:
+		return won && entry.WrongGuesses == 0
+	case RuleLastGuessWin:
+		return won && entry.MaxWrong > 0 && entry.WrongGuesses == entry.MaxWrong-1
+	case RuleDifficultyWin:
+		return won && entry.Difficulty == achievement.Difficulty
+	case RuleLongWordWin:
+		return won && utf8.RuneCountInString(entry.Word) >= achievement.Threshold
+	case RuleFastWin:
+		return won && entry.DurationMs > 0 && entry.Duration() <= time.Duration(achievement.Threshold)*time.Second
+	case RuleDailyStreak:
+		return a.DayStreak >= achievement.Threshold
+	default:
+		return false
+	}
+}
+
+// getAchievementsFilePath returns the path to the achievements file
+func getAchievementsFilePath() string {
+	profileDir, err := ProfileDir()
+	if err != nil {
+		return ".hangman_achievements.json" // Fallback to current directory
+	}
+	return filepath.Join(profileDir, AchievementsFile)
+}
@@ -0,0 +1,157 @@
+package game
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/VinayBhutange/hangman-go/i18n"
+)
+
+// LetterStats describes how one letter has been guessed
+type LetterStats struct {
+	Letter       rune
+	Guessed      int // Games the letter was guessed in
+	Hits         int // Guesses that were in the word
+	FirstGuesses int // Games the letter was guessed first
+	positionSum  int // Sum of the letter's 1-based place in the guess order
+}
+
+// HitRate returns the share of guesses of the letter that were in the word, as a percentage
+func (l LetterStats) HitRate() float64 {
+	if l.Guessed == 0 {
+		return 0.0
+	}
+	return float64(l.Hits) / float64(l.Guessed) * 100
+}
+
+// AveragePosition returns where in the guess order the letter is usually
+// guessed, 1 being the first guess
+func (l LetterStats) AveragePosition() float64 {
+	if l.Guessed == 0 {
+		return 0.0
+	}
+	return float64(l.positionSum) / float64(l.Guessed)
+}
+
+// LengthStats describes the games played with words of one length
+type LengthStats struct {
+	Length int
+	Played int
+	Won    int
+}
+
+// WinRate returns the win rate for the length as a percentage
+func (l LengthStats) WinRate() float64 {
+	if l.Played == 0 {
+		return 0.0
+	}
+	return float64(l.Won) / float64(l.Played) * 100
+}
+
+// Analytics holds letter and word length statistics computed from the game history
+type Analytics struct {
+	Games   int
+	Letters []LetterStats // Most guessed first
+	Lengths []LengthStats // Shortest first
+}
+
+// AnalyzeHistory computes letter and word length statistics from recorded games
+func AnalyzeHistory(history []HistoryEntry) *Analytics {
+	letters := make(map[rune]*LetterStats)
+	lengths := make(map[int]*LengthStats)
+
+	for _, entry := range history {
+		word := strings.ToUpper(entry.Word)
+		position := 0
+		for _, letter := range entry.Guesses {
+			position++
+			stats := letters[letter]
+			if stats == nil {
+				stats = &LetterStats{Letter: letter}
+				letters[letter] = stats
+			}
+			stats.Guessed++
+			stats.positionSum += position
+			if position == 1 {
+				stats.FirstGuesses++
+			}
+			if strings.ContainsRune(word, letter) {
+				stats.Hits++
+			}
+		}
+
+		length := utf8.RuneCountInString(entry.Word)
+		stats := lengths[length]
+		if stats == nil {
+			stats = &LengthStats{Length: length}
+			lengths[length] = stats
+		}
+		stats.Played++
+		if entry.Won() {
+			stats.Won++
+		}
+	}
+
+	analytics := &Analytics{Games: len(history)}
+	for _, stats := range letters {
+		analytics.Letters = append(analytics.Letters, *stats)
+	}
+	sort.Slice(analytics.Letters, func(i, j int) bool {
+		a, b := analytics.Letters[i], analytics.Letters[j]
+		if a.Guessed != b.Guessed {
+			return a.Guessed > b.Guessed
+		}
+		return a.Letter < b.Letter
+	})
+	for _, stats := range lengths {
+		analytics.Lengths = append(analytics.Lengths, *stats)
+	}
+	sort.Slice(analytics.Lengths, func(i, j int) bool {
+		return analytics.Lengths[i].Length < analytics.Lengths[j].Length
+	})
+	return analytics
+}
+
+// Letter returns the statistics of a letter, with zero counts if it was never guessed
+func (a *Analytics) Letter(letter rune) LetterStats {
+	for _, stats := range a.Letters {
+		if stats.Letter == letter {
+			return stats
+		}
+	}
+	return LetterStats{Letter: letter}
+}
+
+// PrintAnalytics prints the letter and word length tables
+func (a *Analytics) PrintAnalytics() {
+	Stdout().Analytics(a)
+}
+
+// Analytics draws the letter and word length tables
+func (r *Renderer) Analytics(a *Analytics) {
+	r.println(i18n.T("analytics.title"))
+	r.println("====================")
+	if a.Games == 0 {
+		r.println(i18n.T("analytics.empty"))
+		r.println()
+		return
+	}
+	r.println(i18n.N("analytics.games", a.Games))
+
+	r.println("\n" + i18n.T("analytics.letters"))
+	r.printf("  %-6s %8s %9s %13s %12s\n", i18n.T("analytics.column.letter"), i18n.T("analytics.column.guessed"),
+		i18n.T("analytics.column.hit_rate"), i18n.T("analytics.column.avg_position"), i18n.T("analytics.column.first_guess"))
+	for _, stats := range a.Letters {
+		r.printf("  %-6c %8d %8.1f%% %13.1f %12d\n", stats.Letter, stats.Guessed, stats.HitRate(),
+			stats.AveragePosition(), stats.FirstGuesses)
+	}
+
+	r.println("\n" + i18n.T("analytics.lengths"))
+	r.printf("  %-6s %8s %8s %9s\n", i18n.T("analytics.column.length"), i18n.T("analytics.column.games"),
+		i18n.T("analytics.column.won"), i18n.T("analytics.column.win_rate"))
+	for _, stats := range a.Lengths {
+		r.printf("  %-6d %8d %8d %8.1f%%\n", stats.Length, stats.Played, stats.Won, stats.WinRate())
+	}
+	r.println()
+}
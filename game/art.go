@@ -0,0 +1,218 @@
+package game
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/VinayBhutange/hangman-go/assets"
+)
+
+// DefaultArtPack is the art pack used until another one is chosen
+const DefaultArtPack = "classic"
+
+// ArtDir is the directory in the data directory holding the player's own art packs
+const ArtDir = "art"
+
+// artSeparator is the line between two stages in an art pack file
+const artSeparator = "---"
+
+// ErrUnknownArtPack is returned for art pack names that aren't built in or in ArtDir
+var ErrUnknownArtPack = errors.New("unknown art pack")
+
+// ArtPack is a series of hangman drawings, from the empty gallows to the
+// finished figure. Any number of stages works; they are spread evenly over the
+// wrong guesses a game allows.
+type ArtPack struct {
+	Name   string
+	Stages []string
+}
+
+// activeArt is the art pack drawn by the renderers
+var activeArt *ArtPack
+
+// ParseArtPack reads an art pack: stages separated by lines of "---", with
+// comment lines starting with "#" allowed before the first stage. Stages
+// shorter than the tallest are padded with blank lines at the top, so the art
+// stays on the ground and the screen doesn't jump.
+func ParseArtPack(name string, data []byte) (*ArtPack, error) {
+	var stages [][]string
+	var stage []string
+	started := false
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		switch {
+		case !started && strings.HasPrefix(line, "#"):
+			continue
+		case line == artSeparator:
+			stages = append(stages, stage)
+			stage = nil
+		default:
+			stage = append(stage, line)
+		}
+		started = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read art pack %s: %w", name, err)
+	}
+	stages = append(stages, stage)
+
+	height := 0
+	for i, lines := range stages {
+		stages[i] = trimBlankLines(lines)
+		if len(stages[i]) == 0 {
+			return nil, fmt.Errorf("art pack %s: stage %d is empty", name, i+1)
+		}
+		if len(stages[i]) > height {
+			height = len(stages[i])
+		}
+	}
+	if len(stages) < 2 {
+		return nil, fmt.Errorf("art pack %s needs at least 2 stages, has %d", name, len(stages))
+	}
+
+	pack := &ArtPack{Name: name, Stages: make([]string, len(stages))}
+	for i, lines := range stages {
+		padding := make([]string, height-len(lines))
+		pack.Stages[i] = strings.Join(append(padding, lines...), "\n")
+	}
+	return pack, nil
+}
+
+// trimBlankLines drops empty lines at the start and end of a stage
+func trimBlankLines(lines []string) []string {
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// LoadArtPack reads an art pack file, named after the file without its extension
+func LoadArtPack(path string) (*ArtPack, error) {
+	//nolint:gosec // G304: File path is provided by the player
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read art pack: %w", err)
+	}
+	return ParseArtPack(strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)), data)
+}
+
+// ArtPacks returns the built-in art packs and the player's own from ArtDir,
+// sorted by name. A pack of the player's with the name of a built-in one
+// replaces it. Packs that can't be read are returned as errors next to the
+// ones that could.
+func ArtPacks() ([]*ArtPack, []error) {
+	packs := make(map[string]*ArtPack)
+	var errs []error
+
+	builtIn, err := fs.Glob(assets.ArtPacks, "art/*.txt")
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for _, path := range builtIn {
+		data, err := assets.ArtPacks.ReadFile(path)
+		if err == nil {
+			var pack *ArtPack
+			if pack, err = ParseArtPack(strings.TrimSuffix(filepath.Base(path), ".txt"), data); err == nil {
+				packs[pack.Name] = pack
+			}
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if dataDir, err := DataDir(); err == nil {
+		files, _ := filepath.Glob(filepath.Join(dataDir, ArtDir, "*.txt")) //nolint:errcheck // The pattern is valid
+		for _, path := range files {
+			pack, err := LoadArtPack(path)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			packs[pack.Name] = pack
+		}
+	}
+
+	list := make([]*ArtPack, 0, len(packs))
+	for _, pack := range packs {
+		list = append(list, pack)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list, errs
+}
+
+// FindArtPack returns the art pack with the given name
+func FindArtPack(name string) (*ArtPack, error) {
+	packs, _ := ArtPacks() // Broken packs simply can't be found
+	for _, pack := range packs {
+		if pack.Name == name {
+			return pack, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnknownArtPack, name)
+}
+
+// UseArtPack makes the renderers draw the named art pack. An empty name
+// selects DefaultArtPack.
+func UseArtPack(name string) error {
+	if name == "" {
+		name = DefaultArtPack
+	}
+	pack, err := FindArtPack(name)
+	if err != nil {
+		return err
+	}
+	activeArt = pack
+	return nil
+}
+
+// ActiveArtPack returns the art pack the renderers draw
+func ActiveArtPack() *ArtPack {
+	if activeArt == nil {
+		pack, err := FindArtPack(DefaultArtPack)
+		if err != nil {
+			panic("built-in art pack is missing: " + err.Error())
+		}
+		activeArt = pack
+	}
+	return activeArt
+}
+
+// Stage returns the drawing for a number of wrong guesses out of maxWrong.
+// The first stage is only shown before any wrong guess and the last only once
+// the game is lost; the stages between are spread evenly over the guesses between.
+func (p *ArtPack) Stage(wrongGuesses, maxWrong int) string {
+	return p.Stages[p.stageIndex(wrongGuesses, maxWrong)]
+}
+
+// stageIndex maps wrong guesses proportionally onto the stages
+func (p *ArtPack) stageIndex(wrongGuesses, maxWrong int) int {
+	last := len(p.Stages) - 1
+	switch {
+	case wrongGuesses <= 0:
+		return 0
+	case wrongGuesses >= maxWrong:
+		return last
+	}
+
+	index := (wrongGuesses*last + maxWrong/2) / maxWrong
+	if index < 1 {
+		index = 1
+	}
+	if last > 1 && index > last-1 {
+		index = last - 1
+	}
+	return index
+}
@@ -0,0 +1,62 @@
+package game
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// writeFileAtomic replaces a file so that readers and crashes only ever see
+// the old or the new contents: the data is written and synced to a temporary
+// file in the same directory, which is then renamed over the original
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(path)
+	temp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	tempName := temp.Name()
+
+	// Remove the temporary file unless it was renamed into place
+	renamed := false
+	defer func() {
+		if !renamed {
+			_ = os.Remove(tempName) //nolint:errcheck // Best effort cleanup
+		}
+	}()
+
+	if _, err := temp.Write(data); err != nil {
+		_ = temp.Close() //nolint:errcheck // The write error is more useful
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+	if err := temp.Sync(); err != nil {
+		_ = temp.Close() //nolint:errcheck // The sync error is more useful
+		return fmt.Errorf("failed to sync temporary file: %w", err)
+	}
+	if err := temp.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary file: %w", err)
+	}
+	if err := os.Chmod(tempName, perm); err != nil {
+		return fmt.Errorf("failed to set file permissions: %w", err)
+	}
+
+	if err := os.Rename(tempName, path); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", filepath.Base(path), err)
+	}
+	renamed = true
+
+	syncDir(dir)
+	return nil
+}
+
+// syncDir flushes a directory entry change such as a rename to disk. Not every
+// platform can sync directories, so errors are ignored.
+func syncDir(dir string) {
+	//nolint:gosec // G304: Directory is controlled by the application
+	d, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+	_ = d.Sync()  //nolint:errcheck // Not supported everywhere
+	_ = d.Close() //nolint:errcheck // Read-only handle
+}
@@ -0,0 +1,168 @@
+package game
+
+import (
+	"bufio"
+	_ "embed" // Needed for the built-in blocklist
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// BlocklistFile is the name of the user's blocklist in the data directory
+const BlocklistFile = "blocklist.txt"
+
+//go:embed blocklist.txt
+var builtinBlocklist string
+
+// blockedSuffixes are the inflections checked against the blocklist in addition to the word itself
+var blockedSuffixes = []string{"S", "ES", "ED", "ER", "ING"}
+
+// Blocklist holds words that must never be offered in a game
+type Blocklist struct {
+	words map[string]bool
+}
+
+// NewBlocklist creates a blocklist containing the given words
+func NewBlocklist(words ...string) *Blocklist {
+	b := &Blocklist{words: make(map[string]bool)}
+	b.Add(words...)
+	return b
+}
+
+// DefaultBlocklist returns the built-in family-friendly blocklist
+func DefaultBlocklist() *Blocklist {
+	b := NewBlocklist()
+	_ = b.read(strings.NewReader(builtinBlocklist)) //nolint:errcheck // Reading from a string cannot fail
+	return b
+}
+
+// LoadBlocklist loads a blocklist file with one word per line; lines starting with # are comments
+func LoadBlocklist(filename string) (*Blocklist, error) {
+	//nolint:gosec // G304: File path is controlled by the application
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open blocklist: %w", err)
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Ignore error on close in defer
+	}()
+
+	b := NewBlocklist()
+	if err := b.read(file); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// LoadUserBlocklist returns the user's blocklist from the data directory, combined
+// with the built-in list when familyFriendly is set. It returns nil when there is
+// nothing to block.
+func LoadUserBlocklist(familyFriendly bool) (*Blocklist, error) {
+	b := NewBlocklist()
+	if familyFriendly {
+		b = DefaultBlocklist()
+	}
+
+	if dataDir, err := DataDir(); err == nil {
+		userFile := filepath.Join(dataDir, BlocklistFile)
+		if _, err := os.Stat(userFile); err == nil {
+			user, err := LoadBlocklist(userFile)
+			if err != nil {
+				return nil, err
+			}
+			b.Merge(user)
+		}
+	}
+
+	if b.Len() == 0 {
+		return nil, nil
+	}
+	return b, nil
+}
+
+// read adds the words from a reader to the blocklist
+func (b *Blocklist) read(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			b.Add(line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error reading blocklist: %w", err)
+	}
+	return nil
+}
+
+// Add adds words to the blocklist
+func (b *Blocklist) Add(words ...string) {
+	for _, word := range words {
+		word = strings.ToUpper(strings.TrimSpace(word))
+		if word != "" {
+			b.words[word] = true
+		}
+	}
+}
+
+// Merge adds every word of another blocklist
+func (b *Blocklist) Merge(other *Blocklist) {
+	for word := range other.words {
+		b.words[word] = true
+	}
+}
+
+// Len returns the number of blocked words
+func (b *Blocklist) Len() int {
+	return len(b.words)
+}
+
+// Contains reports whether a word or its stem is blocked. A nil blocklist blocks nothing.
+func (b *Blocklist) Contains(word string) bool {
+	if b == nil {
+		return false
+	}
+
+	word = strings.ToUpper(strings.TrimSpace(word))
+	if b.words[word] {
+		return true
+	}
+
+	for _, suffix := range blockedSuffixes {
+		if stem := strings.TrimSuffix(word, suffix); stem != word && b.words[stem] {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter splits words into the ones that are allowed and the ones that are blocked
+func (b *Blocklist) Filter(words []string) (allowed, blocked []string) {
+	if b == nil {
+		return words, nil
+	}
+
+	allowed = make([]string, 0, len(words))
+	for _, word := range words {
+		if b.Contains(word) {
+			blocked = append(blocked, word)
+		} else {
+			allowed = append(allowed, word)
+		}
+	}
+	return allowed, blocked
+}
+
+// Words returns the blocked words in alphabetical order
+func (b *Blocklist) Words() []string {
+	words := make([]string, 0, len(b.words))
+	for word := range b.words {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+	return words
+}
@@ -0,0 +1,171 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/VinayBhutange/hangman-go/i18n"
+)
+
+// Breakdown holds the results of the games played at one difficulty or from
+// one word category
+type Breakdown struct {
+	Played        int `json:"played"`
+	Won           int `json:"won"`
+	Lost          int `json:"lost"`
+	WrongGuesses  int `json:"wrong_guesses"`  // Total wrong guesses across the games
+	CurrentStreak int `json:"current_streak"` // Current winning streak
+	LongestStreak int `json:"longest_streak"` // Longest winning streak
+	BestGame      int `json:"best_game"`      // Fewest wrong guesses in a won game, valid once Won > 0
+}
+
+// WinRate returns the win rate as a percentage
+func (b Breakdown) WinRate() float64 {
+	if b.Played == 0 {
+		return 0.0
+	}
+	return float64(b.Won) / float64(b.Played) * 100
+}
+
+// AverageWrongGuesses returns the average number of wrong guesses per game
+func (b Breakdown) AverageWrongGuesses() float64 {
+	if b.Played == 0 {
+		return 0.0
+	}
+	return float64(b.WrongGuesses) / float64(b.Played)
+}
+
+// record adds a finished game
+func (b *Breakdown) record(entry HistoryEntry) {
+	b.Played++
+	b.WrongGuesses += entry.WrongGuesses
+
+	if !entry.Won() {
+		b.Lost++
+		b.CurrentStreak = 0
+		return
+	}
+
+	b.Won++
+	b.CurrentStreak++
+	if b.CurrentStreak > b.LongestStreak {
+		b.LongestStreak = b.CurrentStreak
+	}
+	if b.Won == 1 || entry.WrongGuesses < b.BestGame {
+		b.BestGame = entry.WrongGuesses
+	}
+}
+
+// merge adds imported results, less those of games that were already recorded.
+// Streaks are left alone; the importer recomputes them.
+func (b *Breakdown) merge(imported, duplicates Breakdown) {
+	won := remaining(imported.Won, duplicates.Won)
+	if won > 0 && (b.Won == 0 || imported.BestGame < b.BestGame) {
+		b.BestGame = imported.BestGame
+	}
+	b.Played += remaining(imported.Played, duplicates.Played)
+	b.Won += won
+	b.Lost += remaining(imported.Lost, duplicates.Lost)
+	b.WrongGuesses += remaining(imported.WrongGuesses, duplicates.WrongGuesses)
+}
+
+// DifficultyStats returns the results of the games played at a difficulty
+func (s *Statistics) DifficultyStats(difficulty string) Breakdown {
+	if b := s.ByDifficulty[difficulty]; b != nil {
+		return *b
+	}
+	return Breakdown{}
+}
+
+// CategoryStats returns the results of the games played with words from a category
+func (s *Statistics) CategoryStats(category string) Breakdown {
+	if b := s.ByCategory[category]; b != nil {
+		return *b
+	}
+	return Breakdown{}
+}
+
+// recordBreakdowns adds a finished game to its difficulty and category
+func (s *Statistics) recordBreakdowns(entry HistoryEntry) {
+	breakdownFor(s.ByDifficulty, entry.Difficulty).record(entry)
+	if entry.Category != "" {
+		breakdownFor(s.ByCategory, entry.Category).record(entry)
+	}
+}
+
+// rebuildBreakdowns recomputes the difficulty and category results from the history
+func (s *Statistics) rebuildBreakdowns(history []HistoryEntry) {
+	s.ByDifficulty = make(map[string]*Breakdown)
+	s.ByCategory = make(map[string]*Breakdown)
+	for _, entry := range history {
+		s.recordBreakdowns(entry)
+	}
+}
+
+// mergeBreakdowns adds imported breakdowns, less those of duplicate games, into breakdowns
+func mergeBreakdowns(breakdowns, imported, duplicates map[string]*Breakdown) {
+	for name, b := range imported {
+		var duplicate Breakdown
+		if d := duplicates[name]; d != nil {
+			duplicate = *d
+		}
+		breakdownFor(breakdowns, name).merge(*b, duplicate)
+	}
+}
+
+// breakdownFor returns the breakdown for a name, adding it if needed
+func breakdownFor(breakdowns map[string]*Breakdown, name string) *Breakdown {
+	b := breakdowns[name]
+	if b == nil {
+		b = &Breakdown{}
+		breakdowns[name] = b
+	}
+	return b
+}
+
+// breakdowns draws a table of breakdowns in the given order
+func (r *Renderer) breakdowns(title string, names []string, breakdowns map[string]*Breakdown) {
+	r.println("\n" + title)
+	r.printf("  %-12s %7s %7s %7s %9s %8s %8s %6s %10s\n",
+		"", i18n.T("breakdown.column.played"), i18n.T("breakdown.column.won"), i18n.T("breakdown.column.lost"),
+		i18n.T("breakdown.column.win_rate"), i18n.T("breakdown.column.streak"), i18n.T("breakdown.column.longest"),
+		i18n.T("breakdown.column.best"), i18n.T("breakdown.column.avg_wrong"))
+	for _, name := range names {
+		b := breakdowns[name]
+		best := "-"
+		if b.Won > 0 {
+			best = fmt.Sprint(b.BestGame)
+		}
+		r.printf("  %-12s %7d %7d %7d %8.1f%% %8d %8d %6s %10.1f\n", name, b.Played, b.Won, b.Lost,
+			b.WinRate(), b.CurrentStreak, b.LongestStreak, best, b.AverageWrongGuesses())
+	}
+}
+
+// difficultyOrder returns the difficulties with results, easiest first, then
+// any others alphabetically
+func difficultyOrder(breakdowns map[string]*Breakdown) []string {
+	var names []string
+	for _, difficulty := range []string{DifficultyEasy, DifficultyMedium, DifficultyHard} {
+		if breakdowns[difficulty] != nil {
+			names = append(names, difficulty)
+		}
+	}
+	var others []string
+	for name := range breakdowns {
+		if name != DifficultyEasy && name != DifficultyMedium && name != DifficultyHard {
+			others = append(others, name)
+		}
+	}
+	sort.Strings(others)
+	return append(names, others...)
+}
+
+// categoryOrder returns the categories with results in alphabetical order
+func categoryOrder(breakdowns map[string]*Breakdown) []string {
+	names := make([]string, 0, len(breakdowns))
+	for name := range breakdowns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,98 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Chart characters for Unicode and plain ASCII terminals
+var (
+	unicodeSparks = []rune("▁▂▃▄▅▆▇█")
+	asciiSparks   = []rune("_.-=+*#@")
+)
+
+// Bar characters for Unicode and plain ASCII terminals
+const (
+	unicodeBar = "█"
+	asciiBar   = "#"
+)
+
+// asciiCharts selects plain ASCII characters for charts
+var asciiCharts bool
+
+// UseASCIICharts draws charts with plain ASCII characters instead of Unicode
+// block elements, for terminals and fonts that lack them
+func UseASCIICharts(ascii bool) {
+	asciiCharts = ascii
+}
+
+// DetectASCIICharts reports whether charts should be drawn in ASCII because
+// the locale's character set isn't UTF-8, from the first of LC_ALL, LC_CTYPE
+// and LANG that is set. Without any, the terminal is assumed to handle UTF-8.
+func DetectASCIICharts(getenv func(string) string) bool {
+	for _, key := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if locale := getenv(key); locale != "" {
+			charset := strings.ToUpper(locale)
+			return !strings.Contains(charset, "UTF-8") && !strings.Contains(charset, "UTF8")
+		}
+	}
+	return false
+}
+
+// Sparkline draws values as a single line of bars scaled between zero and the
+// largest value
+func Sparkline(values []float64) string {
+	sparks := unicodeSparks
+	if asciiCharts {
+		sparks = asciiSparks
+	}
+
+	peak := 0.0
+	for _, value := range values {
+		if value > peak {
+			peak = value
+		}
+	}
+
+	var sb strings.Builder
+	for _, value := range values {
+		level := 0
+		if peak > 0 && value > 0 {
+			level = int(value / peak * float64(len(sparks)-1))
+		}
+		sb.WriteRune(sparks[level])
+	}
+	return sb.String()
+}
+
+// BarChart draws one labeled horizontal bar per count, the largest width
+// characters long, and returns the lines
+func BarChart(labels []string, counts []int, width int) []string {
+	bar := unicodeBar
+	if asciiCharts {
+		bar = asciiBar
+	}
+
+	peak, labelWidth := 0, 0
+	for i, count := range counts {
+		if count > peak {
+			peak = count
+		}
+		if len(labels[i]) > labelWidth {
+			labelWidth = len(labels[i])
+		}
+	}
+
+	lines := make([]string, len(counts))
+	for i, count := range counts {
+		length := 0
+		if peak > 0 {
+			length = count * width / peak
+			if length == 0 && count > 0 {
+				length = 1
+			}
+		}
+		lines[i] = fmt.Sprintf("%*s | %s %d", labelWidth, labels[i], strings.Repeat(bar, length), count)
+	}
+	return lines
+}
@@ -0,0 +1,107 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Config holds the player's persistent settings
+type Config struct {
+	FamilyFriendly  bool     `json:"family_friendly"`        // Filter words with the built-in blocklist
+	DisabledSources []string `json:"disabled_sources"`       // Word sources switched off in the settings menu
+	Language        string   `json:"language"`               // Language chosen at the last startup
+	Locale          string   `json:"locale,omitempty"`       // Interface language, empty to follow the environment
+	Profile         string   `json:"profile,omitempty"`      // Profile played last, empty for the default profile
+	ArtPack         string   `json:"art_pack,omitempty"`     // Hangman art pack, empty for DefaultArtPack
+	Theme           string   `json:"theme,omitempty"`        // Color theme, empty for utils.DefaultTheme
+	Accessible      bool     `json:"accessible,omitempty"`   // Screen reader mode
+	ASCIICharts     bool     `json:"ascii_charts,omitempty"` // Draw charts with ASCII characters
+
+	// History retention, zero keeps every game
+	HistoryMaxGames int `json:"history_max_games,omitempty"` // Newest games to keep
+	HistoryMaxDays  int `json:"history_max_days,omitempty"`  // Days to keep games for
+}
+
+// NewConfig creates a config with default settings
+func NewConfig() *Config {
+	return &Config{
+		DisabledSources: make([]string, 0),
+	}
+}
+
+// LoadConfig loads the config from file, returning defaults if there is none
+func LoadConfig() (*Config, error) {
+	configFile := getConfigFilePath()
+
+	if _, err := os.Stat(configFile); os.IsNotExist(err) {
+		return NewConfig(), nil
+	}
+
+	//nolint:gosec // G304: File path is controlled by the application
+	data, err := os.ReadFile(configFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	config := NewConfig()
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	return config, nil
+}
+
+// SaveConfig saves the config to file
+func (c *Config) SaveConfig() error {
+	configFile := getConfigFilePath()
+
+	if err := os.MkdirAll(filepath.Dir(configFile), 0o750); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	if err := writeFileAtomic(configFile, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+
+	return nil
+}
+
+// IsSourceDisabled reports whether a word source has been switched off
+func (c *Config) IsSourceDisabled(name string) bool {
+	for _, disabled := range c.DisabledSources {
+		if disabled == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SetSourceDisabled records whether a word source is switched off
+func (c *Config) SetSourceDisabled(name string, disabled bool) {
+	kept := c.DisabledSources[:0]
+	for _, existing := range c.DisabledSources {
+		if existing != name {
+			kept = append(kept, existing)
+		}
+	}
+	if disabled {
+		kept = append(kept, name)
+	}
+	c.DisabledSources = kept
+}
+
+// getConfigFilePath returns the path to the config file
+func getConfigFilePath() string {
+	configDir, err := ConfigDir()
+	if err != nil {
+		return ".hangman_config.json" // Fallback to current directory
+	}
+	return filepath.Join(configDir, "config.json")
+}
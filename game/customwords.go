@@ -0,0 +1,148 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// WordsDir is the directory in a profile's directory holding its word files
+const WordsDir = "words"
+
+// CustomWordsFile is the word file in WordsDir holding the words added from the
+// settings menu. It is loaded like any other word file, as the custom source.
+const CustomWordsFile = CustomSource + ".txt"
+
+// RemovedWordsFile is the file in a profile's directory listing the words
+// removed from the settings menu. It sits outside WordsDir so it isn't loaded
+// as a word file.
+const RemovedWordsFile = "removed_words.txt"
+
+// customWordsHeader is written at the top of CustomWordsFile
+var customWordsHeader = []string{"# Words added from the settings menu"}
+
+// removedWordsHeader is written at the top of RemovedWordsFile
+var removedWordsHeader = []string{"# Words removed from the settings menu, left out of every word list"}
+
+// ProfileWordsDir returns the active profile's word directory
+func ProfileWordsDir() (string, error) {
+	dir, err := ProfileDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, WordsDir), nil
+}
+
+// SaveCustomWord adds a word to the active profile's CustomWordsFile, so it is
+// still there next time, and takes it off the removed words
+func SaveCustomWord(word string) error {
+	word = strings.ToUpper(strings.TrimSpace(word))
+	dir, err := ProfileWordsDir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create word directory: %w", err)
+	}
+	if err := updateWordFile(filepath.Join(dir, CustomWordsFile), customWordsHeader, word, true); err != nil {
+		return err
+	}
+
+	removed, err := removedWordsPath()
+	if err != nil {
+		return err
+	}
+	return updateWordFile(removed, removedWordsHeader, word, false)
+}
+
+// SaveRemovedWord records a word as removed for the active profile, so it
+// stays out of the word list next time, and drops it from CustomWordsFile
+func SaveRemovedWord(word string) error {
+	word = strings.ToUpper(strings.TrimSpace(word))
+	dir, err := ProfileWordsDir()
+	if err != nil {
+		return err
+	}
+	if err := updateWordFile(filepath.Join(dir, CustomWordsFile), customWordsHeader, word, false); err != nil {
+		return err
+	}
+
+	removed, err := removedWordsPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(removed), 0o750); err != nil {
+		return fmt.Errorf("failed to create profile directory: %w", err)
+	}
+	return updateWordFile(removed, removedWordsHeader, word, true)
+}
+
+// LoadRemovedWords returns the words the active profile removed from the
+// settings menu, uppercased
+func LoadRemovedWords() ([]string, error) {
+	path, err := removedWordsPath()
+	if err != nil {
+		return nil, err
+	}
+	wf, err := ReadWordFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	words := make([]string, len(wf.Entries))
+	for i, entry := range wf.Entries {
+		words[i] = strings.ToUpper(entry)
+	}
+	return words, nil
+}
+
+// RemoveWords removes each of the words from the word list
+func (wl *WordList) RemoveWords(words []string) {
+	for _, word := range words {
+		wl.RemoveWord(word)
+	}
+}
+
+// removedWordsPath returns the path of the active profile's RemovedWordsFile
+func removedWordsPath() (string, error) {
+	dir, err := ProfileDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, RemovedWordsFile), nil
+}
+
+// updateWordFile adds a word to a word file or takes it out, keeping the
+// file's header. A file that doesn't exist is only created to add a word.
+func updateWordFile(path string, header []string, word string, add bool) error {
+	var words []string
+	wf, err := ReadWordFile(path)
+	switch {
+	case err == nil:
+		header = wf.Header
+		for _, entry := range wf.Entries {
+			if !strings.EqualFold(entry, word) {
+				words = append(words, entry)
+			}
+		}
+		if !add && len(words) == len(wf.Entries) {
+			return nil // Nothing to take out
+		}
+	case errors.Is(err, os.ErrNotExist):
+		if !add {
+			return nil
+		}
+	default:
+		return err
+	}
+
+	if add {
+		words = append(words, word)
+	}
+	return WriteWordFile(path, header, words)
+}
@@ -0,0 +1,489 @@
+package game
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/VinayBhutange/hangman-go/utils"
+)
+
+// ImportOptions controls how dictionary words are turned into a word pack
+type ImportOptions struct {
+	ExpandAffixes bool      // Generate affixed forms from the .aff rules instead of only stripping flags
+	MinLength     int       // Shortest word to keep, 0 for no limit
+	MaxLength     int       // Longest word to keep, 0 for no limit
+	Language      *Language // Language whose alphabet words must use; nil uses utils.IsValidWord
+}
+
+// DefaultImportOptions returns options that keep words usable at any difficulty
+func DefaultImportOptions() ImportOptions {
+	return ImportOptions{
+		MinLength: difficultyRanges[DifficultyEasy].min,
+		MaxLength: difficultyRanges[DifficultyHard].max,
+	}
+}
+
+// affixRule is a single PFX or SFX line of a hunspell .aff file
+type affixRule struct {
+	strip     string
+	affix     string
+	condition []charClass
+}
+
+// affixGroup holds every rule sharing one flag
+type affixGroup struct {
+	prefix       bool
+	crossProduct bool
+	rules        []affixRule
+}
+
+// charClass matches a single character of an affix condition
+type charClass struct {
+	any    bool
+	negate bool
+	chars  string
+}
+
+// affixFile is the subset of a hunspell .aff file needed to expand words
+type affixFile struct {
+	flagType string // "", "long", "num" or "UTF-8"
+	groups   map[string]*affixGroup
+	decode   decoder // Decodes the .aff and .dic files from the SET encoding
+}
+
+// decoder turns a line of a dictionary file into UTF-8
+type decoder func(line []byte) (string, error)
+
+// errNotUTF8 is returned for lines of a UTF-8 dictionary that aren't valid UTF-8
+var errNotUTF8 = errors.New("not valid UTF-8")
+
+// iso885915 holds the characters of ISO-8859-15 that differ from ISO-8859-1
+var iso885915 = map[byte]rune{
+	0xA4: '€', 0xA6: 'Š', 0xA8: 'š', 0xB4: 'Ž', 0xB8: 'ž', 0xBC: 'Œ', 0xBD: 'œ', 0xBE: 'Ÿ',
+}
+
+// newDecoder returns the decoder for the encoding named by a SET directive.
+// Without one, lines are read as UTF-8, or as ISO-8859-1 (hunspell's default)
+// when they aren't valid UTF-8.
+func newDecoder(set string) (decoder, error) {
+	name := strings.NewReplacer("-", "", "_", "").Replace(strings.ToUpper(set))
+	switch name {
+	case "":
+		return func(line []byte) (string, error) {
+			if utf8.Valid(line) {
+				return string(line), nil
+			}
+			return decodeLatin(line, nil), nil
+		}, nil
+	case "UTF8":
+		return func(line []byte) (string, error) {
+			if !utf8.Valid(line) {
+				return "", errNotUTF8
+			}
+			return string(line), nil
+		}, nil
+	case "ISO88591":
+		return func(line []byte) (string, error) { return decodeLatin(line, nil), nil }, nil
+	case "ISO885915":
+		return func(line []byte) (string, error) { return decodeLatin(line, iso885915), nil }, nil
+	default:
+		return nil, fmt.Errorf("unsupported dictionary encoding %s, convert it to UTF-8 first (e.g. with iconv)", set)
+	}
+}
+
+// decodeLatin decodes ISO-8859-1, where every byte is the character with the
+// same code, with the exceptions of a variant such as ISO-8859-15
+func decodeLatin(line []byte, exceptions map[byte]rune) string {
+	var sb strings.Builder
+	for _, b := range line {
+		if r, ok := exceptions[b]; ok {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteRune(rune(b))
+		}
+	}
+	return sb.String()
+}
+
+// ImportHunspell reads a hunspell .dic file and its .aff rules and returns the
+// words that use only valid letters and fit the length limits, uppercased and deduplicated.
+// affPath may be empty when affixes are only stripped.
+func ImportHunspell(dicPath, affPath string, opts ImportOptions) ([]string, error) {
+	decode, _ := newDecoder("") //nolint:errcheck // The default encoding is always supported
+	aff := &affixFile{groups: make(map[string]*affixGroup), decode: decode}
+	if affPath != "" {
+		var err error
+		if aff, err = parseAffixFile(affPath); err != nil {
+			return nil, err
+		}
+	} else if opts.ExpandAffixes {
+		return nil, fmt.Errorf("expanding affixes requires an .aff file")
+	}
+
+	lines, err := readDictionaryLines(dicPath, aff.decode)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first line of a .dic file is the approximate word count
+	if len(lines) > 0 {
+		if _, err := strconv.Atoi(lines[0]); err == nil {
+			lines = lines[1:]
+		}
+	}
+
+	var candidates []string
+	for _, line := range lines {
+		stem, flags := splitDictionaryEntry(line)
+		candidates = append(candidates, stem)
+		if opts.ExpandAffixes {
+			candidates = append(candidates, aff.expand(stem, aff.parseFlags(flags))...)
+		}
+	}
+
+	return filterImportedWords(candidates, opts), nil
+}
+
+// ImportAspell reads an aspell word dump (as produced by "aspell dump master")
+// and returns the usable words, stripping any affix flags
+func ImportAspell(path string, opts ImportOptions) ([]string, error) {
+	decode, _ := newDecoder("") //nolint:errcheck // The default encoding is always supported
+	lines, err := readDictionaryLines(path, decode)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]string, 0, len(lines))
+	for _, line := range lines {
+		stem, _ := splitDictionaryEntry(line)
+		candidates = append(candidates, stem)
+	}
+
+	return filterImportedWords(candidates, opts), nil
+}
+
+// readDictionaryLines returns the trimmed, non-empty lines of a dictionary
+// file, decoded to UTF-8
+func readDictionaryLines(path string, decode decoder) ([]string, error) {
+	raw, err := readRawLines(path)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]string, 0, len(raw))
+	for n, line := range raw {
+		text, err := decode(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, n+1, err)
+		}
+		if text = strings.TrimSpace(text); text != "" {
+			lines = append(lines, text)
+		}
+	}
+	return lines, nil
+}
+
+// readRawLines returns the lines of a file as they are, without decoding them
+func readRawLines(path string) ([][]byte, error) {
+	//nolint:gosec // G304: File path is controlled by the application
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open dictionary: %w", err)
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Ignore error on close in defer
+	}()
+
+	var lines [][]byte
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, append([]byte(nil), scanner.Bytes()...))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading dictionary: %w", err)
+	}
+
+	return lines, nil
+}
+
+// splitDictionaryEntry splits "word/FLAGS po:noun" into the word and its flags
+func splitDictionaryEntry(line string) (stem, flags string) {
+	if i := strings.IndexAny(line, " \t"); i >= 0 {
+		line = line[:i]
+	}
+	if i := strings.Index(line, "/"); i >= 0 {
+		return line[:i], line[i+1:]
+	}
+	return line, ""
+}
+
+// filterImportedWords keeps valid words within the length limits, uppercased and deduplicated
+func filterImportedWords(candidates []string, opts ImportOptions) []string {
+	seen := make(map[string]bool)
+	var words []string
+
+	for _, candidate := range candidates {
+		word := strings.ToUpper(candidate)
+		if opts.Language != nil {
+			word = opts.Language.Normalize(candidate)
+			if !opts.Language.IsPlayable(word) {
+				continue
+			}
+		} else if !utils.IsValidWord(candidate) {
+			continue
+		}
+
+		length := utf8.RuneCountInString(word)
+		if (opts.MinLength > 0 && length < opts.MinLength) || (opts.MaxLength > 0 && length > opts.MaxLength) {
+			continue
+		}
+
+		if !seen[word] {
+			seen[word] = true
+			words = append(words, word)
+		}
+	}
+
+	return words
+}
+
+// parseAffixFile reads the SET, FLAG, PFX and SFX directives of a hunspell
+// .aff file. SET, which names the encoding of both files, is looked for first.
+func parseAffixFile(path string) (*affixFile, error) {
+	raw, err := readRawLines(path)
+	if err != nil {
+		return nil, err
+	}
+
+	set := ""
+	for _, line := range raw {
+		if fields := strings.Fields(string(line)); len(fields) > 1 && fields[0] == "SET" {
+			set = fields[1]
+			break
+		}
+	}
+	decode, err := newDecoder(set)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	lines, err := readDictionaryLines(path, decode)
+	if err != nil {
+		return nil, err
+	}
+
+	aff := &affixFile{groups: make(map[string]*affixGroup), decode: decode}
+	for n, line := range lines {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		switch fields[0] {
+		case "FLAG":
+			if len(fields) > 1 {
+				aff.flagType = fields[1]
+			}
+		case "PFX", "SFX":
+			if err := aff.addAffixLine(fields); err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", path, n+1, err)
+			}
+		}
+	}
+
+	return aff, nil
+}
+
+// addAffixLine handles either an affix header ("SFX D Y 4") or a rule ("SFX D y ied [^aeiou]y")
+func (a *affixFile) addAffixLine(fields []string) error {
+	if len(fields) < 4 {
+		return fmt.Errorf("malformed %s line", fields[0])
+	}
+
+	flag := fields[1]
+	group, ok := a.groups[flag]
+	if !ok {
+		// First line for a flag is the header
+		a.groups[flag] = &affixGroup{
+			prefix:       fields[0] == "PFX",
+			crossProduct: fields[2] == "Y",
+		}
+		return nil
+	}
+
+	rule := affixRule{strip: fields[2], affix: fields[3]}
+	if rule.strip == "0" {
+		rule.strip = ""
+	}
+	// Continuation flags on the affix are not followed
+	if i := strings.Index(rule.affix, "/"); i >= 0 {
+		rule.affix = rule.affix[:i]
+	}
+	if rule.affix == "0" {
+		rule.affix = ""
+	}
+
+	condition := "."
+	if len(fields) > 4 {
+		condition = fields[4]
+	}
+	parsed, err := parseCondition(condition)
+	if err != nil {
+		return err
+	}
+	rule.condition = parsed
+
+	group.rules = append(group.rules, rule)
+	return nil
+}
+
+// parseCondition parses an affix condition such as "[^aeiou]y" into character classes
+func parseCondition(condition string) ([]charClass, error) {
+	if condition == "." {
+		return nil, nil
+	}
+
+	var classes []charClass
+	runes := []rune(condition)
+	for i := 0; i < len(runes); i++ {
+		switch runes[i] {
+		case '.':
+			classes = append(classes, charClass{any: true})
+		case '[':
+			end := i + 1
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("unterminated condition %q", condition)
+			}
+			class := charClass{chars: string(runes[i+1 : end])}
+			if strings.HasPrefix(class.chars, "^") {
+				class.negate = true
+				class.chars = class.chars[1:]
+			}
+			classes = append(classes, class)
+			i = end
+		default:
+			classes = append(classes, charClass{chars: string(runes[i])})
+		}
+	}
+
+	return classes, nil
+}
+
+// matches reports whether a character satisfies the class
+func (c charClass) matches(r rune) bool {
+	if c.any {
+		return true
+	}
+	return strings.ContainsRune(c.chars, r) != c.negate
+}
+
+// parseFlags splits a flag string according to the FLAG type of the .aff file
+func (a *affixFile) parseFlags(flags string) []string {
+	if flags == "" {
+		return nil
+	}
+
+	switch a.flagType {
+	case "long":
+		var result []string
+		runes := []rune(flags)
+		for i := 0; i+1 < len(runes); i += 2 {
+			result = append(result, string(runes[i:i+2]))
+		}
+		return result
+	case "num":
+		return strings.Split(flags, ",")
+	default:
+		result := make([]string, 0, len(flags))
+		for _, r := range flags {
+			result = append(result, string(r))
+		}
+		return result
+	}
+}
+
+// expand generates the affixed forms of a stem for the given flags. As in
+// hunspell, a suffixed form only takes a prefix when both affixes allow cross
+// products.
+func (a *affixFile) expand(stem string, flags []string) []string {
+	var prefixed, suffixed, crossSuffixed []string
+	var crossPrefixes []*affixGroup
+
+	for _, flag := range flags {
+		group, ok := a.groups[flag]
+		if !ok {
+			continue
+		}
+		for _, rule := range group.rules {
+			form, ok := rule.apply(stem, group.prefix)
+			switch {
+			case !ok:
+				continue
+			case group.prefix:
+				prefixed = append(prefixed, form)
+			default:
+				suffixed = append(suffixed, form)
+				if group.crossProduct {
+					crossSuffixed = append(crossSuffixed, form)
+				}
+			}
+		}
+		if group.prefix && group.crossProduct {
+			crossPrefixes = append(crossPrefixes, group)
+		}
+	}
+
+	// Cross products combine each cross-product suffixed form with the cross-product prefixes
+	var combined []string
+	for _, form := range crossSuffixed {
+		for _, group := range crossPrefixes {
+			for _, rule := range group.rules {
+				if word, ok := rule.apply(form, true); ok {
+					combined = append(combined, word)
+				}
+			}
+		}
+	}
+
+	result := append(prefixed, suffixed...)
+	return append(result, combined...)
+}
+
+// apply applies the rule to a word if its condition matches
+func (r affixRule) apply(word string, prefix bool) (string, bool) {
+	runes := []rune(word)
+	if len(runes) < len(r.condition) {
+		return "", false
+	}
+
+	if prefix {
+		for i, class := range r.condition {
+			if !class.matches(runes[i]) {
+				return "", false
+			}
+		}
+		if !strings.HasPrefix(word, r.strip) {
+			return "", false
+		}
+		return r.affix + strings.TrimPrefix(word, r.strip), true
+	}
+
+	offset := len(runes) - len(r.condition)
+	for i, class := range r.condition {
+		if !class.matches(runes[offset+i]) {
+			return "", false
+		}
+	}
+	if !strings.HasSuffix(word, r.strip) {
+		return "", false
+	}
+	return strings.TrimSuffix(word, r.strip) + r.affix, true
+}
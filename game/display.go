@@ -6,12 +6,19 @@ import (
 	"os/exec"
 	"runtime"
 	"strings"
+	"unicode/utf8"
 
+	"github.com/VinayBhutange/hangman-go/i18n"
 	"github.com/VinayBhutange/hangman-go/utils"
 )
 
-// ClearScreen clears the terminal screen
+// ClearScreen clears the terminal screen, except in screen reader mode, where
+// what was said before should stay available
 func ClearScreen() {
+	if accessible {
+		return
+	}
+
 	var cmd *exec.Cmd
 
 	switch runtime.GOOS {
@@ -27,182 +34,229 @@ func ClearScreen() {
 
 // DisplayWelcome shows the welcome message and game instructions
 func DisplayWelcome() {
-	fmt.Println(utils.Bold("🎮 WELCOME TO HANGMAN GAME! 🎮"))
-	fmt.Println("================================")
-	fmt.Println()
-	fmt.Println(utils.Blue("📖 HOW TO PLAY:"))
-	fmt.Println("• Guess the hidden word letter by letter")
-	fmt.Println("• You have 6 wrong guesses before you lose")
-	fmt.Println("• Enter one letter at a time")
-	fmt.Println("• Good luck!")
-	fmt.Println()
-	fmt.Println("Press Enter to start...")
+	Stdout().Welcome()
 }
 
 // DisplayGameState shows the current state of the game
 func DisplayGameState(g *Game) {
-	fmt.Println(utils.Bold("🎯 HANGMAN GAME"))
-	fmt.Println("===============")
-	fmt.Println()
+	Stdout().GameState(g)
+}
+
+// DisplayKeyboard shows the active alphabet with hits in green, misses in red
+// and unused letters plain
+func DisplayKeyboard(g *Game) {
+	Stdout().Keyboard(g)
+}
+
+// DisplayHangman shows the hangman figure for wrong guesses out of maxWrong,
+// or says how many wrong guesses are used in screen reader mode
+func DisplayHangman(wrongGuesses, maxWrong int) {
+	Stdout().Hangman(wrongGuesses, maxWrong)
+}
+
+// DisplayWinMessage shows the win message
+func DisplayWinMessage(word string) {
+	Stdout().WinMessage(word)
+}
+
+// DisplayLoseMessage shows the lose message
+func DisplayLoseMessage(word string) {
+	Stdout().LoseMessage(word)
+}
+
+// DisplayInvalidInput shows invalid input message
+func DisplayInvalidInput(message string) {
+	Stdout().InvalidInput(message)
+}
+
+// DisplayAlreadyGuessed shows already guessed message
+func DisplayAlreadyGuessed(letter rune) {
+	Stdout().AlreadyGuessed(letter)
+}
+
+// DisplayCorrectGuess shows correct guess message
+func DisplayCorrectGuess(letter rune) {
+	Stdout().CorrectGuess(letter)
+}
+
+// DisplayWrongGuess shows wrong guess message
+func DisplayWrongGuess(letter rune) {
+	Stdout().WrongGuess(letter)
+}
+
+// DisplayGameStats shows game statistics
+func DisplayGameStats(gamesPlayed, gamesWon int) {
+	Stdout().GameStats(gamesPlayed, gamesWon)
+}
+
+// Welcome draws the welcome message and game instructions
+func (r *Renderer) Welcome() {
+	r.title(r.bold(i18n.T("display.welcome")), 32)
+	r.println()
+	r.println(r.blue(i18n.T("display.how_to_play")))
+	r.println("• " + i18n.T("display.rule_guess"))
+	r.println("• " + i18n.N("display.rule_wrong_guesses", 6))
+	r.println("• " + i18n.T("display.rule_one_letter"))
+	r.println("• " + i18n.T("display.rule_good_luck"))
+	r.println()
+	r.println(i18n.T("display.press_enter_start"))
+}
+
+// GameState draws the current state of the game
+func (r *Renderer) GameState(g *Game) {
+	if r.accessible {
+		r.describeGame(g)
+		return
+	}
+
+	r.title(r.bold(i18n.T("display.game_title")), 15)
+	r.println()
 
 	// Display hangman figure
-	DisplayHangman(g.WrongGuesses)
-	fmt.Println()
+	r.Hangman(g.WrongGuesses, g.MaxWrongGuesses)
+	r.println()
 
 	// Display word progress with colors
 	displayWord := g.GetDisplayWord()
-	fmt.Printf("Word: %s\n", utils.Bold(utils.Cyan(displayWord)))
-	fmt.Println()
+	r.println(i18n.T("display.word", r.bold(r.cyan(displayWord))))
+	r.println()
+
+	// Display the alphabet with used letters marked
+	r.Keyboard(g)
+	r.println()
 
 	// Display game statistics with colors
 	remaining := g.GetRemainingGuesses()
-	wrongColor := utils.Red
+	wrongColor := r.red
 	if g.WrongGuesses <= 2 {
-		wrongColor = utils.Green
+		wrongColor = r.green
 	} else if g.WrongGuesses <= 4 {
-		wrongColor = utils.Yellow
+		wrongColor = r.yellow
 	}
 
-	fmt.Printf("Wrong guesses: %s/%d\n", wrongColor(fmt.Sprintf("%d", g.WrongGuesses)), g.MaxWrongGuesses)
+	r.println(i18n.T("display.wrong_guesses", wrongColor(fmt.Sprintf("%d", g.WrongGuesses)), g.MaxWrongGuesses))
 
-	remainingColor := utils.Green
+	remainingColor := r.green
 	if remaining <= 2 {
-		remainingColor = utils.Red
+		remainingColor = r.red
 	} else if remaining <= 3 {
-		remainingColor = utils.Yellow
+		remainingColor = r.yellow
 	}
 
-	fmt.Printf("Remaining guesses: %s\n", remainingColor(fmt.Sprintf("%d", remaining)))
-	fmt.Println()
+	r.println(i18n.T("display.remaining_guesses", remainingColor(fmt.Sprintf("%d", remaining))))
+	r.println()
 
 	// Display guessed letters with colors
 	wrongLetters := g.GetWrongLetters()
 	if len(wrongLetters) > 0 {
-		fmt.Printf("Wrong letters: %s\n", utils.Red(formatLetters(wrongLetters)))
+		r.println(i18n.T("display.wrong_letters", r.red(formatLetters(wrongLetters))))
 	}
 
 	guessedLetters := g.GetGuessedLetters()
 	if len(guessedLetters) > 0 {
-		fmt.Printf("All guessed letters: %s\n", utils.Blue(formatLetters(guessedLetters)))
-	}
-	fmt.Println()
-}
-
-// DisplayHangman shows the hangman figure based on wrong guesses
-func DisplayHangman(wrongGuesses int) {
-	stages := []string{
-		// Stage 0: Empty gallows
-		`
-   +---+
-   |   |
-       |
-       |
-       |
-       |
-=========`,
-		// Stage 1: Head
-		`
-   +---+
-   |   |
-   O   |
-       |
-       |
-       |
-=========`,
-		// Stage 2: Body
-		`
-   +---+
-   |   |
-   O   |
-   |   |
-       |
-       |
-=========`,
-		// Stage 3: Left arm
-		`
-   +---+
-   |   |
-   O   |
-  /|   |
-       |
-       |
-=========`,
-		// Stage 4: Right arm
-		`
-   +---+
-   |   |
-   O   |
-  /|\  |
-       |
-       |
-=========`,
-		// Stage 5: Left leg
-		`
-   +---+
-   |   |
-   O   |
-  /|\  |
-  /    |
-       |
-=========`,
-		// Stage 6: Right leg (complete hangman)
-		`
-   +---+
-   |   |
-   O   |
-  /|\  |
-  / \  |
-       |
-=========`,
-	}
-
-	if wrongGuesses >= 0 && wrongGuesses < len(stages) {
-		fmt.Print(stages[wrongGuesses])
+		r.println(i18n.T("display.guessed_letters", r.blue(formatLetters(guessedLetters))))
 	}
+	r.println()
 }
 
-// DisplayWinMessage shows the win message
-func DisplayWinMessage(word string) {
-	fmt.Println("🎉 CONGRATULATIONS! 🎉")
-	fmt.Println("======================")
-	fmt.Printf("You guessed the word: %s\n", word)
-	fmt.Println("You win! 🏆")
-	fmt.Println()
+// keyboardRowLength is the number of letters per keyboard row
+const keyboardRowLength = 13
+
+// Keyboard draws the active alphabet with hits in green, misses in red and
+// unused letters plain, in rows that fit the screen width. In screen reader
+// mode it lists the letters not guessed yet.
+func (r *Renderer) Keyboard(g *Game) {
+	if r.accessible {
+		r.describeKeyboard(g)
+		return
+	}
+
+	rowLength := keyboardRowLength
+	if fit := (r.width + 1) / 2; fit < rowLength {
+		rowLength = fit
+	}
+
+	alphabet := utils.Alphabet()
+	for i, letter := range alphabet {
+		key := string(letter)
+		if g.GuessedLetters[letter] {
+			if strings.ContainsRune(g.Word, letter) {
+				key = r.green(key)
+			} else {
+				key = r.red("·")
+			}
+		}
+
+		r.printf("%s", key)
+		if (i+1)%rowLength == 0 || i == len(alphabet)-1 {
+			r.println()
+		} else {
+			r.printf(" ")
+		}
+	}
 }
 
-// DisplayLoseMessage shows the lose message
-func DisplayLoseMessage(word string) {
-	fmt.Println("💀 GAME OVER 💀")
-	fmt.Println("===============")
-	fmt.Printf("The word was: %s\n", word)
-	fmt.Println("Better luck next time! 😔")
-	fmt.Println()
+// Hangman draws the stage of the renderer's art pack for wrong guesses out of
+// maxWrong, or says how many are used in screen reader mode
+func (r *Renderer) Hangman(wrongGuesses, maxWrong int) {
+	if r.accessible {
+		r.describeWrongGuesses(wrongGuesses, maxWrong)
+		return
+	}
+	r.printf("\n%s", r.art.Stage(wrongGuesses, maxWrong))
 }
 
-// DisplayInvalidInput shows invalid input message
-func DisplayInvalidInput(message string) {
-	fmt.Printf("❌ Invalid input: %s\n", message)
-	fmt.Println("Please enter a single letter (A-Z)")
-	fmt.Println()
+// WinMessage draws the win message
+func (r *Renderer) WinMessage(word string) {
+	r.title(i18n.T("display.congratulations"), 22)
+	r.println(i18n.T("display.you_guessed", word))
+	r.println(i18n.T("display.you_win"))
+	r.println()
 }
 
-// DisplayAlreadyGuessed shows already guessed message
-func DisplayAlreadyGuessed(letter rune) {
-	fmt.Printf("⚠️  You already guessed '%c'!\n", letter)
-	fmt.Println("Try a different letter.")
-	fmt.Println()
+// LoseMessage draws the lose message
+func (r *Renderer) LoseMessage(word string) {
+	r.title(i18n.T("display.game_over"), 15)
+	r.println(i18n.T("display.word_was", word))
+	r.println(i18n.T("display.better_luck"))
+	r.println()
 }
 
-// DisplayCorrectGuess shows correct guess message
-func DisplayCorrectGuess(letter rune) {
-	fmt.Printf("✅ Great! '%c' is in the word!\n", letter)
-	fmt.Println()
+// InvalidInput draws the invalid input message
+func (r *Renderer) InvalidInput(message string) {
+	r.println(r.decorate("❌ ", i18n.T("input.invalid", message)))
+	r.println(i18n.T("input.single_letter", utils.DescribeAlphabet()))
+	r.println()
 }
 
-// DisplayWrongGuess shows wrong guess message
-func DisplayWrongGuess(letter rune) {
-	fmt.Printf("❌ Sorry, '%c' is not in the word.\n", letter)
-	fmt.Println()
+// AlreadyGuessed draws the already guessed message
+func (r *Renderer) AlreadyGuessed(letter rune) {
+	r.println(r.decorate("⚠️  ", i18n.T("game.already_guessed", letter)))
+	r.println()
+}
+
+// CorrectGuess draws the correct guess message
+func (r *Renderer) CorrectGuess(letter rune) {
+	r.println(r.decorate("✅ ", i18n.T("game.correct_guess", letter)))
+	r.println()
+}
+
+// WrongGuess draws the wrong guess message
+func (r *Renderer) WrongGuess(letter rune) {
+	r.println(r.decorate("❌ ", i18n.T("game.wrong_guess", letter)))
+	r.println()
+}
+
+// GameStats draws a short summary of games played and won
+func (r *Renderer) GameStats(gamesPlayed, gamesWon int) {
+	r.title(i18n.T("stats.title"), 18)
+	r.println(i18n.T("stats.games_played", gamesPlayed))
+	r.println(i18n.T("stats.games_won", gamesWon))
+	if gamesPlayed > 0 {
+		winRate := float64(gamesWon) / float64(gamesPlayed) * 100
+		r.println(i18n.T("stats.win_rate", winRate))
+	}
+	r.println()
 }
 
 // formatLetters formats a slice of runes as a comma-separated string
@@ -219,15 +273,79 @@ func formatLetters(letters []rune) string {
 	return strings.Join(strs, ", ")
 }
 
-// DisplayGameStats shows game statistics
-func DisplayGameStats(gamesPlayed, gamesWon int) {
-	fmt.Println("📊 GAME STATISTICS")
-	fmt.Println("==================")
-	fmt.Printf("Games played: %d\n", gamesPlayed)
-	fmt.Printf("Games won: %d\n", gamesWon)
-	if gamesPlayed > 0 {
-		winRate := float64(gamesWon) / float64(gamesPlayed) * 100
-		fmt.Printf("Win rate: %.1f%%\n", winRate)
+// artPreviewGap is the space between drawings in an art preview
+const artPreviewGap = 3
+
+// ArtPreview draws an art pack as it appears after each wrong guess from none
+// to maxWrong, side by side in as many rows as the screen width needs. Screen
+// reader mode only says how many drawings there are.
+func (r *Renderer) ArtPreview(pack *ArtPack, maxWrong int) {
+	if r.accessible {
+		r.println(i18n.N("access.art_hidden", len(pack.Stages), pack.Name, len(pack.Stages)))
+		return
+	}
+
+	var drawings [][]string
+	columnWidth := 0
+	for wrong := 0; wrong <= maxWrong; wrong++ {
+		lines := strings.Split(pack.Stage(wrong, maxWrong), "\n")
+		for _, line := range lines {
+			if width := utf8.RuneCountInString(line); width > columnWidth {
+				columnWidth = width
+			}
+		}
+		drawings = append(drawings, lines)
+	}
+
+	perRow := (r.width + artPreviewGap) / (columnWidth + artPreviewGap)
+	if perRow < 1 {
+		perRow = 1
+	}
+	for start := 0; start < len(drawings); start += perRow {
+		end := start + perRow
+		if end > len(drawings) {
+			end = len(drawings)
+		}
+		row := drawings[start:end]
+
+		// Label each drawing with its number of wrong guesses
+		labels := make([]string, len(row))
+		for i := range row {
+			labels[i] = fmt.Sprint(start + i)
+		}
+		r.previewLine(labels, columnWidth)
+		for line := 0; line < len(row[0]); line++ {
+			cells := make([]string, len(row))
+			for i, drawing := range row {
+				cells[i] = drawing[line]
+			}
+			r.previewLine(cells, columnWidth)
+		}
+		r.println()
+	}
+}
+
+// previewLine writes cells padded to columnWidth, without trailing spaces
+func (r *Renderer) previewLine(cells []string, columnWidth int) {
+	var sb strings.Builder
+	for i, cell := range cells {
+		if i > 0 {
+			sb.WriteString(strings.Repeat(" ", artPreviewGap))
+		}
+		sb.WriteString(cell)
+		sb.WriteString(strings.Repeat(" ", columnWidth-utf8.RuneCountInString(cell)))
+	}
+	r.println(strings.TrimRight(sb.String(), " "))
+}
+
+// ThemePreview draws a sample of a color theme's palette
+func (r *Renderer) ThemePreview(theme utils.Theme) {
+	sample := []string{
+		r.paint(i18n.T("appearance.sample.hit"), theme.Green),
+		r.paint(i18n.T("appearance.sample.miss"), theme.Red),
+		r.paint(i18n.T("appearance.sample.warning"), theme.Yellow),
+		r.paint(i18n.T("appearance.sample.info"), theme.Blue),
+		r.paint("G O _ _ _ _", theme.Cyan),
 	}
-	fmt.Println()
+	r.println(strings.Join(sample, "  "))
 }
@@ -0,0 +1,383 @@
+package game
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Export formats
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// ErrUnknownFormat is returned for export formats other than FormatJSON and FormatCSV
+var ErrUnknownFormat = errors.New("unknown export format, use json or csv")
+
+// historyColumns are the CSV columns of an exported history, one game per row
+var historyColumns = []string{
+	"played_at", "word", "difficulty", "category", "guesses",
+	"wrong_guesses", "max_wrong", "duration_ms", "result", "seed",
+}
+
+// Export bundles statistics with the games behind them, so both can be moved
+// to another machine in one file
+type Export struct {
+	Statistics *Statistics    `json:"statistics"`
+	History    []HistoryEntry `json:"history"`
+}
+
+// ImportResult describes what an import added
+type ImportResult struct {
+	Games      int // Games new to this profile
+	Duplicates int // Games that were already recorded and were skipped
+	Untracked  int // New games without a history entry, counted again if imported again
+}
+
+// ExportStatistics writes statistics and history as a JSON bundle, or the
+// statistics alone as CSV rows of metric and value
+func ExportStatistics(w io.Writer, format string, s *Statistics, history []HistoryEntry) error {
+	switch format {
+	case FormatJSON:
+		if history == nil {
+			history = []HistoryEntry{}
+		}
+		s.Version = StatsVersion
+		return writeJSON(w, Export{Statistics: s, History: history})
+	case FormatCSV:
+		return writeStatisticsCSV(w, s)
+	default:
+		return ErrUnknownFormat
+	}
+}
+
+// ExportHistory writes games as a JSON array or as CSV with one game per row
+func ExportHistory(w io.Writer, format string, history []HistoryEntry) error {
+	switch format {
+	case FormatJSON:
+		if history == nil {
+			history = []HistoryEntry{}
+		}
+		return writeJSON(w, history)
+	case FormatCSV:
+		return writeHistoryCSV(w, history)
+	default:
+		return ErrUnknownFormat
+	}
+}
+
+// writeJSON writes a value as indented JSON
+func writeJSON(w io.Writer, v interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Errorf("failed to write JSON: %w", err)
+	}
+	return nil
+}
+
+// writeStatisticsCSV writes one metric per row, with per-difficulty and
+// per-source counts as difficulty:<name> and source:<name>
+func writeStatisticsCSV(w io.Writer, s *Statistics) error {
+	lastPlayed := ""
+	if !s.LastPlayed.IsZero() {
+		lastPlayed = s.LastPlayed.Format(time.RFC3339)
+	}
+
+	rows := [][]string{
+		{"metric", "value"},
+		{"games_played", strconv.Itoa(s.GamesPlayed)},
+		{"games_won", strconv.Itoa(s.GamesWon)},
+		{"games_lost", strconv.Itoa(s.GamesLost)},
+		{"win_rate", strconv.FormatFloat(s.GetWinRate(), 'f', 1, 64)},
+		{"total_guesses", strconv.Itoa(s.TotalGuesses)},
+		{"correct_guesses", strconv.Itoa(s.CorrectGuesses)},
+		{"wrong_guesses", strconv.Itoa(s.WrongGuesses)},
+		{"best_game", strconv.Itoa(s.BestGame)},
+		{"current_streak", strconv.Itoa(s.CurrentStreak)},
+		{"longest_streak", strconv.Itoa(s.LongestStreak)},
+		{"last_played", lastPlayed},
+	}
+	for _, name := range sortedCounts(s.Difficulties) {
+		rows = append(rows, []string{"difficulty:" + name, strconv.Itoa(s.Difficulties[name])})
+	}
+	for _, name := range sortedCounts(s.Sources) {
+		rows = append(rows, []string{"source:" + name, strconv.Itoa(s.Sources[name])})
+	}
+
+	return writeCSV(w, rows)
+}
+
+// writeHistoryCSV writes one game per row
+func writeHistoryCSV(w io.Writer, history []HistoryEntry) error {
+	rows := [][]string{historyColumns}
+	for _, entry := range history {
+		rows = append(rows, []string{
+			entry.PlayedAt.Format(time.RFC3339),
+			entry.Word,
+			entry.Difficulty,
+			entry.Category,
+			entry.Guesses,
+			strconv.Itoa(entry.WrongGuesses),
+			strconv.Itoa(entry.MaxWrong),
+			strconv.FormatInt(entry.DurationMs, 10),
+			entry.Result,
+			strconv.FormatInt(entry.Seed, 10),
+		})
+	}
+	return writeCSV(w, rows)
+}
+
+// writeCSV writes rows as CSV
+func writeCSV(w io.Writer, rows [][]string) error {
+	writer := csv.NewWriter(w)
+	if err := writer.WriteAll(rows); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+// sortedCounts returns the keys of a count map in alphabetical order
+func sortedCounts(counts map[string]int) []string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ReadImport reads statistics to import. It accepts a bundle written by
+// ExportStatistics or a plain stats.json; for the latter, a history.jsonl next
+// to it is read as well when there is one. Games that can't have been played,
+// such as with negative wrong guesses, make the whole import fail.
+func ReadImport(path string) (*Statistics, []HistoryEntry, error) {
+	//nolint:gosec // G304: File path is provided by the player
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read import file: %w", err)
+	}
+
+	var bundle struct {
+		Statistics json.RawMessage `json:"statistics"`
+		History    []HistoryEntry  `json:"history"`
+	}
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse import file: %w", err)
+	}
+
+	var stats *Statistics
+	var version int
+	var history []HistoryEntry
+	if len(bundle.Statistics) > 0 {
+		stats, version, err = parseStatistics(bundle.Statistics)
+		history = bundle.History
+	} else {
+		stats, version, err = parseStatistics(data)
+		if err == nil {
+			history, err = readHistoryFile(filepath.Join(filepath.Dir(path), HistoryFile))
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, entry := range history {
+		if err := entry.Validate(); err != nil {
+			return nil, nil, fmt.Errorf("game %d of the import: %w", i+1, err)
+		}
+	}
+
+	if version < breakdownStatsVersion {
+		stats.rebuildBreakdowns(history)
+	}
+	return stats, history, nil
+}
+
+// parseStatistics migrates and decodes statistics from any supported version,
+// returning the version they were written in
+func parseStatistics(data []byte) (*Statistics, int, error) {
+	migrated, version, err := migrateStatistics(data)
+	if err != nil {
+		return nil, version, err
+	}
+
+	stats := NewStatistics()
+	if err := json.Unmarshal(migrated, stats); err != nil {
+		return nil, version, fmt.Errorf("failed to parse statistics: %w", err)
+	}
+	return stats, version, nil
+}
+
+// Import merges statistics from another file into s. Counts are summed, games
+// already in this profile's history are skipped, and streaks are recomputed
+// from the combined history. The import is queued like a recorded game: the
+// next SaveStatistics applies it again to whatever is saved by then, under the
+// lock, and appends the new games to the history. Games in a stats.json
+// imported without its history can't be recognized, so importing it again
+// counts them again; ImportResult.Untracked says how many there were.
+func (s *Statistics) Import(other *Statistics, otherHistory []HistoryEntry) (ImportResult, error) {
+	saved, err := s.statsStore().LoadHistory()
+	if err != nil {
+		return ImportResult{}, err
+	}
+
+	// Games imported earlier but not saved yet are known too
+	history := append(append([]HistoryEntry(nil), saved...), s.pendingHistory...)
+	for _, earlier := range s.pendingImports {
+		history = append(history, earlier.history...)
+	}
+
+	result, added := s.mergeImport(history, other, otherHistory)
+	s.pendingImports = append(s.pendingImports, pendingImport{stats: other, history: otherHistory})
+	s.importedHistory = append(s.importedHistory, added...)
+	return result, nil
+}
+
+// pendingImport is an import waiting for the next save
+type pendingImport struct {
+	stats   *Statistics
+	history []HistoryEntry
+}
+
+// mergeSaved returns the statistics to save: the saved statistics with the
+// games recorded and the statistics imported since s was loaded applied to
+// them, or s itself when there are none saved or s replaces them. It also
+// returns the games to append to the history. savedHistory reads the history
+// as it is saved, and is only called for imports.
+func (s *Statistics) mergeSaved(saved *Statistics, savedHistory func() ([]HistoryEntry, error)) (*Statistics, []HistoryEntry, error) {
+	appended := append([]HistoryEntry(nil), s.pendingHistory...)
+	if saved == nil || s.replace {
+		// s already holds its imports
+		return s, append(appended, s.importedHistory...), nil
+	}
+
+	for _, entry := range s.pendingHistory {
+		saved.apply(entry)
+	}
+	if len(s.pendingImports) == 0 {
+		return saved, appended, nil
+	}
+
+	history, err := savedHistory()
+	if err != nil {
+		return nil, nil, err
+	}
+	history = append(history, s.pendingHistory...)
+	for _, imp := range s.pendingImports {
+		_, added := saved.mergeImport(history, imp.stats, imp.history)
+		history = append(history, added...)
+		appended = append(appended, added...)
+	}
+	return saved, appended, nil
+}
+
+// mergeImport merges imported statistics into s, given every game s already
+// knows about, and returns the imported games that were new
+func (s *Statistics) mergeImport(history []HistoryEntry, other *Statistics, otherHistory []HistoryEntry) (ImportResult, []HistoryEntry) {
+	known := make(map[string]bool, len(history))
+	for _, entry := range history {
+		known[entry.key()] = true
+	}
+
+	// Games already recorded here are taken back out of the imported counts
+	var result ImportResult
+	duplicates := NewStatistics()
+	var added []HistoryEntry
+	for _, entry := range otherHistory {
+		if known[entry.key()] {
+			duplicates.apply(entry)
+			result.Duplicates++
+			continue
+		}
+		known[entry.key()] = true
+		added = append(added, entry)
+	}
+
+	s.GamesPlayed += remaining(other.GamesPlayed, duplicates.GamesPlayed)
+	s.GamesWon += remaining(other.GamesWon, duplicates.GamesWon)
+	s.GamesLost += remaining(other.GamesLost, duplicates.GamesLost)
+	s.TotalGuesses += remaining(other.TotalGuesses, duplicates.TotalGuesses)
+	s.CorrectGuesses += remaining(other.CorrectGuesses, duplicates.CorrectGuesses)
+	s.WrongGuesses += remaining(other.WrongGuesses, duplicates.WrongGuesses)
+	mergeCounts(s.Difficulties, other.Difficulties, duplicates.Difficulties)
+	mergeCounts(s.Sources, other.Sources, duplicates.Sources)
+	mergeBreakdowns(s.ByDifficulty, other.ByDifficulty, duplicates.ByDifficulty)
+	mergeBreakdowns(s.ByCategory, other.ByCategory, duplicates.ByCategory)
+	if other.BestGame < s.BestGame {
+		s.BestGame = other.BestGame
+	}
+	if other.LastPlayed.After(s.LastPlayed) {
+		s.LastPlayed = other.LastPlayed
+	}
+	result.Games = remaining(other.GamesPlayed, duplicates.GamesPlayed)
+	result.Untracked = remaining(result.Games, len(added))
+
+	// Streaks and recent words follow the combined history in play order
+	combined := append(append([]HistoryEntry(nil), history...), added...)
+	sort.SliceStable(combined, func(i, j int) bool {
+		return combined[i].PlayedAt.Before(combined[j].PlayedAt)
+	})
+	if len(combined) > 0 {
+		replayed := NewStatistics()
+		for _, entry := range combined {
+			replayed.apply(entry)
+		}
+		s.CurrentStreak = replayed.CurrentStreak
+		s.WordsGuessed = replayed.WordsGuessed
+		if replayed.LongestStreak > s.LongestStreak {
+			s.LongestStreak = replayed.LongestStreak
+		}
+		mergeStreaks(s.ByDifficulty, replayed.ByDifficulty)
+		mergeStreaks(s.ByCategory, replayed.ByCategory)
+	}
+	if other.LongestStreak > s.LongestStreak {
+		s.LongestStreak = other.LongestStreak
+	}
+	return result, added
+}
+
+// mergeStreaks takes current streaks from breakdowns replayed from the full
+// history, keeping the longer of the longest streaks
+func mergeStreaks(breakdowns, replayed map[string]*Breakdown) {
+	for name, b := range breakdowns {
+		r := replayed[name]
+		if r == nil {
+			continue
+		}
+		b.CurrentStreak = r.CurrentStreak
+		if r.LongestStreak > b.LongestStreak {
+			b.LongestStreak = r.LongestStreak
+		}
+	}
+}
+
+// mergeCounts adds the imported counts, less those of duplicate games, into counts
+func mergeCounts(counts, imported, duplicates map[string]int) {
+	for name, count := range imported {
+		if count = remaining(count, duplicates[name]); count > 0 {
+			counts[name] += count
+		}
+	}
+}
+
+// remaining returns what is left of an imported count once duplicate games are
+// taken out, never less than zero
+func remaining(imported, duplicates int) int {
+	if imported < duplicates {
+		return 0
+	}
+	return imported - duplicates
+}
+
+// key identifies a game for de-duplication
+func (e HistoryEntry) key() string {
+	return e.PlayedAt.UTC().Format(time.RFC3339Nano) + "|" + e.Word + "|" + e.Guesses + "|" + strconv.FormatInt(e.Seed, 10)
+}
@@ -3,8 +3,10 @@ package game
 
 import (
 	"math/rand"
+	"sort"
 	"strings"
 	"time"
+	"unicode"
 )
 
 // Game represents the current state of a hangman game
@@ -15,20 +17,29 @@ type Game struct {
 	MaxWrongGuesses int           // Maximum wrong guesses allowed
 	IsGameOver      bool          // Whether the game has ended
 	IsWon           bool          // Whether the player has won
+	Source          string        // Tag of the word source the word came from, if known
+	Guesses         []rune        // Letters in the order they were guessed
+	Seed            int64         // Seed the word was picked with
+	StartedAt       time.Time     // When the game started
+	EndedAt         time.Time     // When the game ended, zero while it is running
 }
 
 // NewGame creates a new hangman game with a random word
 func NewGame(words []string) *Game {
+	return NewGameWithSeed(words, time.Now().UnixNano())
+}
+
+// NewGameWithSeed creates a new hangman game, picking the word with the given
+// seed so the same word list and seed always give the same word
+func NewGameWithSeed(words []string, seed int64) *Game {
 	if len(words) == 0 {
 		panic("No words provided for the game")
 	}
 
-	// Seed random number generator
-	rand.Seed(time.Now().UnixNano())
-
 	// Select a random word (using math/rand is fine for games)
 	//nolint:gosec // G404: Using weak random number generator is acceptable for game purposes
-	word := strings.ToUpper(words[rand.Intn(len(words))])
+	rng := rand.New(rand.NewSource(seed))
+	word := strings.ToUpper(words[rng.Intn(len(words))])
 
 	return &Game{
 		Word:            word,
@@ -37,6 +48,8 @@ func NewGame(words []string) *Game {
 		MaxWrongGuesses: 6, // Standard hangman allows 6 wrong guesses
 		IsGameOver:      false,
 		IsWon:           false,
+		Seed:            seed,
+		StartedAt:       time.Now(),
 	}
 }
 
@@ -47,7 +60,7 @@ func (g *Game) GuessLetter(letter rune) bool {
 	}
 
 	// Convert to uppercase for consistency
-	letter = rune(strings.ToUpper(string(letter))[0])
+	letter = unicode.ToUpper(letter)
 
 	// Check if letter was already guessed
 	if g.GuessedLetters[letter] {
@@ -56,6 +69,7 @@ func (g *Game) GuessLetter(letter rune) bool {
 
 	// Mark letter as guessed
 	g.GuessedLetters[letter] = true
+	g.Guesses = append(g.Guesses, letter)
 
 	// Check if letter is in the word
 	isCorrect := strings.ContainsRune(g.Word, letter)
@@ -99,16 +113,17 @@ func (g *Game) IsWordComplete() bool {
 	return true
 }
 
-// GetGuessedLetters returns a slice of all guessed letters
+// GetGuessedLetters returns a slice of all guessed letters in alphabetical order
 func (g *Game) GetGuessedLetters() []rune {
 	var letters []rune
 	for letter := range g.GuessedLetters {
 		letters = append(letters, letter)
 	}
+	sortRunes(letters)
 	return letters
 }
 
-// GetWrongLetters returns a slice of incorrectly guessed letters
+// GetWrongLetters returns a slice of incorrectly guessed letters in alphabetical order
 func (g *Game) GetWrongLetters() []rune {
 	var wrongLetters []rune
 	for letter := range g.GuessedLetters {
@@ -116,9 +131,26 @@ func (g *Game) GetWrongLetters() []rune {
 			wrongLetters = append(wrongLetters, letter)
 		}
 	}
+	sortRunes(wrongLetters)
 	return wrongLetters
 }
 
+// sortRunes sorts letters in place
+func sortRunes(letters []rune) {
+	sort.Slice(letters, func(i, j int) bool { return letters[i] < letters[j] })
+}
+
+// Duration returns how long the game took, or has taken so far
+func (g *Game) Duration() time.Duration {
+	if g.StartedAt.IsZero() {
+		return 0
+	}
+	if g.EndedAt.IsZero() {
+		return time.Since(g.StartedAt)
+	}
+	return g.EndedAt.Sub(g.StartedAt)
+}
+
 // GetRemainingGuesses returns the number of remaining wrong guesses
 func (g *Game) GetRemainingGuesses() int {
 	return g.MaxWrongGuesses - g.WrongGuesses
@@ -130,6 +162,7 @@ func (g *Game) updateGameState() {
 	if g.IsWordComplete() {
 		g.IsGameOver = true
 		g.IsWon = true
+		g.EndedAt = time.Now()
 		return
 	}
 
@@ -137,6 +170,7 @@ func (g *Game) updateGameState() {
 	if g.WrongGuesses >= g.MaxWrongGuesses {
 		g.IsGameOver = true
 		g.IsWon = false
+		g.EndedAt = time.Now()
 		return
 	}
 }
@@ -147,12 +181,16 @@ func (g *Game) Reset(words []string) {
 		panic("No words provided for the game")
 	}
 
+	g.Seed = time.Now().UnixNano()
 	//nolint:gosec // G404: Using weak random number generator is acceptable for game purposes
-	word := strings.ToUpper(words[rand.Intn(len(words))])
+	word := strings.ToUpper(words[rand.New(rand.NewSource(g.Seed)).Intn(len(words))])
 
 	g.Word = word
 	g.GuessedLetters = make(map[rune]bool)
+	g.Guesses = nil
 	g.WrongGuesses = 0
 	g.IsGameOver = false
 	g.IsWon = false
+	g.StartedAt = time.Now()
+	g.EndedAt = time.Time{}
 }
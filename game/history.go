@@ -0,0 +1,261 @@
+package game
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// HistoryFile is the name of the game history log in the profile directory
+const HistoryFile = "history.jsonl"
+
+// Game results recorded in the history
+const (
+	ResultWon  = "won"
+	ResultLost = "lost"
+)
+
+// maxWrongLimit is the most wrong guesses a recorded game can allow, more
+// than any alphabet has letters
+const maxWrongLimit = 100
+
+// ErrInvalidEntry is returned for history entries that can't describe a game
+var ErrInvalidEntry = errors.New("invalid history entry")
+
+// HistoryEntry records a single finished game
+type HistoryEntry struct {
+	PlayedAt     time.Time `json:"played_at"`
+	Word         string    `json:"word"`
+	Difficulty   string    `json:"difficulty"`
+	Category     string    `json:"category,omitempty"` // Word source tag
+	Guesses      string    `json:"guesses"`            // Letters in the order they were guessed
+	WrongGuesses int       `json:"wrong_guesses"`
+	MaxWrong     int       `json:"max_wrong"`
+	DurationMs   int64     `json:"duration_ms"`
+	Result       string    `json:"result"` // ResultWon or ResultLost
+	Seed         int64     `json:"seed"`
+}
+
+// NewHistoryEntry describes a finished game for the history
+func NewHistoryEntry(g *Game, difficulty string) HistoryEntry {
+	entry := HistoryEntry{
+		PlayedAt:     g.EndedAt,
+		Word:         g.Word,
+		Difficulty:   difficulty,
+		Category:     g.Source,
+		Guesses:      string(g.Guesses),
+		WrongGuesses: g.WrongGuesses,
+		MaxWrong:     g.MaxWrongGuesses,
+		DurationMs:   g.Duration().Milliseconds(),
+		Result:       ResultLost,
+		Seed:         g.Seed,
+	}
+	if entry.PlayedAt.IsZero() {
+		entry.PlayedAt = time.Now()
+	}
+	if g.IsWon {
+		entry.Result = ResultWon
+	}
+	return entry
+}
+
+// Won reports whether the game was won
+func (e HistoryEntry) Won() bool {
+	return e.Result == ResultWon
+}
+
+// Validate checks that the entry could describe a finished game, such as one
+// read from an import
+func (e HistoryEntry) Validate() error {
+	switch {
+	case e.Result != ResultWon && e.Result != ResultLost:
+		return fmt.Errorf("%w: unknown result %q", ErrInvalidEntry, e.Result)
+	case e.MaxWrong < 0 || e.MaxWrong > maxWrongLimit:
+		return fmt.Errorf("%w: %d wrong guesses allowed", ErrInvalidEntry, e.MaxWrong)
+	case !e.wrongInRange():
+		return fmt.Errorf("%w: %d wrong guesses out of %d", ErrInvalidEntry, e.WrongGuesses, e.MaxWrong)
+	case e.DurationMs < 0:
+		return fmt.Errorf("%w: negative duration", ErrInvalidEntry)
+	}
+	return nil
+}
+
+// wrongInRange reports whether the wrong guesses are within what the game
+// allowed. Entries that don't say may have up to maxWrongLimit.
+func (e HistoryEntry) wrongInRange() bool {
+	limit := e.MaxWrong
+	if limit == 0 {
+		limit = maxWrongLimit
+	}
+	return e.WrongGuesses >= 0 && e.WrongGuesses <= limit
+}
+
+// Duration returns how long the game took
+func (e HistoryEntry) Duration() time.Duration {
+	return time.Duration(e.DurationMs) * time.Millisecond
+}
+
+// AppendHistory adds entries to the end of the active profile's history in
+// the current store
+func AppendHistory(entries ...HistoryEntry) error {
+	return currentStore().AppendHistory(entries...)
+}
+
+// appendHistoryFile adds entries to the end of the history log at historyFile
+func appendHistoryFile(historyFile string, entries ...HistoryEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(historyFile), 0o750); err != nil {
+		return fmt.Errorf("failed to create history directory: %w", err)
+	}
+
+	lock, err := lockFile(historyFile)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = lock.Unlock() //nolint:errcheck // Ignore error on unlock in defer
+	}()
+
+	//nolint:gosec // G304: File path is controlled by the application
+	file, err := os.OpenFile(historyFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open history file: %w", err)
+	}
+
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			_ = file.Close() //nolint:errcheck // The encoding error is more useful
+			return fmt.Errorf("failed to write history: %w", err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		_ = file.Close() //nolint:errcheck // The write error is more useful
+		return fmt.Errorf("failed to write history: %w", err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close history file: %w", err)
+	}
+	return nil
+}
+
+// LoadHistory returns every game the active profile recorded in the current
+// store, oldest first
+func LoadHistory() ([]HistoryEntry, error) {
+	return currentStore().LoadHistory()
+}
+
+// readHistoryFile reads a history log, returning no entries if it doesn't
+// exist. Lines that can't be parsed, such as one cut short by a crash, are skipped.
+func readHistoryFile(path string) ([]HistoryEntry, error) {
+	//nolint:gosec // G304: File path is controlled by the application
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history file: %w", err)
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Ignore error on close in defer
+	}()
+
+	var entries []HistoryEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry HistoryEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading history file: %w", err)
+	}
+
+	return entries, nil
+}
+
+// PruneHistory applies the retention settings to the active profile's history
+// in the current store, dropping games older than maxDays and all but the
+// newest maxEntries games. Zero keeps everything. It returns how many games
+// were removed.
+func PruneHistory(maxEntries, maxDays int) (int, error) {
+	return currentStore().PruneHistory(maxEntries, maxDays)
+}
+
+// pruneHistoryFile applies the retention settings to the history log at historyFile
+func pruneHistoryFile(historyFile string, maxEntries, maxDays int) (int, error) {
+	if maxEntries <= 0 && maxDays <= 0 {
+		return 0, nil
+	}
+
+	if _, err := os.Stat(historyFile); os.IsNotExist(err) {
+		return 0, nil
+	}
+
+	lock, err := lockFile(historyFile)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = lock.Unlock() //nolint:errcheck // Ignore error on unlock in defer
+	}()
+
+	entries, err := readHistoryFile(historyFile)
+	if err != nil {
+		return 0, err
+	}
+
+	kept := pruneEntries(entries, maxEntries, maxDays)
+	removed := len(entries) - len(kept)
+	if removed == 0 {
+		return 0, nil
+	}
+	return removed, writeHistory(historyFile, kept)
+}
+
+// pruneEntries returns the entries kept by the retention settings
+func pruneEntries(entries []HistoryEntry, maxEntries, maxDays int) []HistoryEntry {
+	kept := entries
+	if maxDays > 0 {
+		cutoff := time.Now().AddDate(0, 0, -maxDays)
+		kept = make([]HistoryEntry, 0, len(entries))
+		for _, entry := range entries {
+			if !entry.PlayedAt.Before(cutoff) {
+				kept = append(kept, entry)
+			}
+		}
+	}
+	if maxEntries > 0 && len(kept) > maxEntries {
+		kept = kept[len(kept)-maxEntries:]
+	}
+	return kept
+}
+
+// writeHistory replaces the history log at historyFile with the given entries
+func writeHistory(historyFile string, entries []HistoryEntry) error {
+	var data []byte
+	for _, entry := range entries {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("failed to marshal history: %w", err)
+		}
+		data = append(append(data, line...), '\n')
+	}
+
+	if err := writeFileAtomic(historyFile, data, 0o600); err != nil {
+		return fmt.Errorf("failed to replace history file: %w", err)
+	}
+	return nil
+}
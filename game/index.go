@@ -0,0 +1,189 @@
+package game
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// maxLetterBits is the number of distinct letters a letter mask can track
+const maxLetterBits = 64
+
+// wordGroup holds the positions of the words sharing a length or category
+type wordGroup struct {
+	members []int // Positions in wordIndex.words
+}
+
+// wordIndex groups the words of a list by length, category and letters, in
+// the order of the list. Words can be added in constant time; removing words
+// means building a new index.
+type wordIndex struct {
+	words []string
+	masks []uint64 // Letter mask of each word
+
+	// Every word belongs to one length group and one category group
+	byLength   map[int]*wordGroup
+	byCategory map[string]*wordGroup
+	maxLength  int
+
+	letterBits map[rune]uint // Letter -> bit in the masks
+	overflow   bool          // Some letters had no bit left and must be checked by hand
+}
+
+// newWordIndex indexes words, tagging each with its category
+func newWordIndex(words []string, categoryOf func(string) string) *wordIndex {
+	n := len(words)
+	idx := &wordIndex{
+		words:      make([]string, 0, n),
+		masks:      make([]uint64, 0, n),
+		byLength:   make(map[int]*wordGroup),
+		byCategory: make(map[string]*wordGroup),
+		letterBits: make(map[rune]uint),
+	}
+	for _, word := range words {
+		idx.add(word, categoryOf(word))
+	}
+	return idx
+}
+
+// add indexes one occurrence of a word
+func (idx *wordIndex) add(word, category string) {
+	pos := len(idx.words)
+	idx.words = append(idx.words, word)
+	idx.masks = append(idx.masks, idx.mask(word, true))
+
+	length := utf8.RuneCountInString(word)
+	if length > idx.maxLength {
+		idx.maxLength = length
+	}
+	lengthGroup := idx.byLength[length]
+	if lengthGroup == nil {
+		lengthGroup = &wordGroup{}
+		idx.byLength[length] = lengthGroup
+	}
+	categoryGroup := idx.byCategory[category]
+	if categoryGroup == nil {
+		categoryGroup = &wordGroup{}
+		idx.byCategory[category] = categoryGroup
+	}
+
+	lengthGroup.members = append(lengthGroup.members, pos)
+	categoryGroup.members = append(categoryGroup.members, pos)
+}
+
+// wordsOf returns a copy of the words in a group
+func (idx *wordIndex) wordsOf(group *wordGroup) []string {
+	if group == nil || len(group.members) == 0 {
+		return nil
+	}
+	words := make([]string, len(group.members))
+	for i, pos := range group.members {
+		words[i] = idx.words[pos]
+	}
+	return words
+}
+
+// mask returns the letter mask of a word, assigning bits to new letters when assign is set
+func (idx *wordIndex) mask(word string, assign bool) uint64 {
+	var mask uint64
+	for _, r := range word {
+		bit, ok := idx.letterBits[r]
+		if !ok {
+			if !assign {
+				continue
+			}
+			if len(idx.letterBits) == maxLetterBits {
+				idx.overflow = true
+				continue
+			}
+			bit = uint(len(idx.letterBits))
+			idx.letterBits[r] = bit
+		}
+		mask |= 1 << bit
+	}
+	return mask
+}
+
+// withLetters returns the words of a group, or of the whole index when group
+// is nil, that contain every letter of include and none of exclude
+func (idx *wordIndex) withLetters(group *wordGroup, include, exclude []rune) []string {
+	checkByHand := false // Some letters have no bit and must be checked on the word
+	for _, r := range include {
+		if _, ok := idx.letterBits[r]; !ok {
+			if !idx.overflow {
+				return nil // No word has this letter
+			}
+			checkByHand = true
+		}
+	}
+	for _, r := range exclude {
+		if _, ok := idx.letterBits[r]; !ok && idx.overflow {
+			checkByHand = true
+		}
+	}
+
+	want := idx.mask(string(include), false)
+	avoid := idx.mask(string(exclude), false)
+
+	var result []string
+	matches := func(pos int) {
+		mask := idx.masks[pos]
+		if mask&want != want || mask&avoid != 0 {
+			return
+		}
+		if checkByHand && !hasLetters(idx.words[pos], include, exclude) {
+			return
+		}
+		result = append(result, idx.words[pos])
+	}
+
+	if group == nil {
+		for pos := range idx.words {
+			matches(pos)
+		}
+	} else {
+		for _, pos := range group.members {
+			matches(pos)
+		}
+	}
+	return result
+}
+
+// hasLetters checks a word against include and exclude letters without the index
+func hasLetters(word string, include, exclude []rune) bool {
+	for _, r := range include {
+		if !strings.ContainsRune(word, r) {
+			return false
+		}
+	}
+	for _, r := range exclude {
+		if strings.ContainsRune(word, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// currentIndex returns the list's index, building it if it is missing or
+// Words was replaced since it was built
+func (wl *WordList) currentIndex() *wordIndex {
+	if wl.index == nil || !sameSlice(wl.index.words, wl.Words) {
+		wl.index = newWordIndex(wl.Words, func(word string) string {
+			return wl.sourceOf[word]
+		})
+		wl.Words = wl.index.words
+	}
+	return wl.index
+}
+
+// sameSlice reports whether two slices are the same view of the same array,
+// which a slice of equal length put in its place is not
+func sameSlice(a, b []string) bool {
+	if len(a) != len(b) || cap(a) != cap(b) {
+		return false
+	}
+	if len(a) == 0 && len(b) == 0 {
+		// Compare the arrays behind empty slices; without any, both index nothing
+		return cap(a) == 0 || &a[:1][0] == &b[:1][0]
+	}
+	return &a[0] == &b[0]
+}
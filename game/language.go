@@ -0,0 +1,149 @@
+package game
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/VinayBhutange/hangman-go/utils"
+)
+
+// DefaultLanguage is the language used when none is chosen
+const DefaultLanguage = "en"
+
+// Language describes the letters a word pack is played with
+type Language struct {
+	Code     string          // ISO 639-1 code such as "en"
+	Name     string          // Name shown to players
+	Alphabet []rune          // Uppercase letters that can be guessed
+	Fold     map[rune]string // Letters rewritten before play, such as ß -> SS
+}
+
+// basicLatin is the A-Z alphabet shared by the built-in languages
+const basicLatin = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+var languages = map[string]*Language{
+	"en": {
+		Code:     "en",
+		Name:     "English",
+		Alphabet: []rune(basicLatin),
+	},
+	"de": {
+		Code:     "de",
+		Name:     "Deutsch",
+		Alphabet: []rune(basicLatin + "ÄÖÜ"),
+		Fold:     map[rune]string{'ß': "SS", 'ẞ': "SS"},
+	},
+	"es": {
+		Code:     "es",
+		Name:     "Español",
+		Alphabet: []rune("ABCDEFGHIJKLMNÑOPQRSTUVWXYZ"),
+		// Accents are not guessed separately, except for Ñ which is its own letter
+		Fold: map[rune]string{'Á': "A", 'É': "E", 'Í': "I", 'Ó': "O", 'Ú': "U", 'Ü': "U"},
+	},
+}
+
+// GetLanguage returns the language with the given code
+func GetLanguage(code string) (*Language, bool) {
+	lang, ok := languages[strings.ToLower(strings.TrimSpace(code))]
+	return lang, ok
+}
+
+// Languages returns every built-in language ordered by code
+func Languages() []*Language {
+	result := make([]*Language, 0, len(languages))
+	for _, lang := range languages {
+		result = append(result, lang)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Code < result[j].Code
+	})
+	return result
+}
+
+// ParseLanguageHeader reads the "# language: xx" and "# alphabet: ..." comment
+// lines at the top of a word pack. It returns nil when no language is declared.
+// An alphabet line overrides the built-in alphabet, or defines one for a language
+// that is not built in.
+func ParseLanguageHeader(lines []string) *Language {
+	var code, alphabet string
+	for _, line := range lines {
+		key, value, ok := strings.Cut(strings.TrimSpace(strings.TrimPrefix(line, "#")), ":")
+		if !ok {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "language":
+			code = strings.ToLower(strings.TrimSpace(value))
+		case "alphabet":
+			alphabet = strings.ToUpper(strings.Join(strings.Fields(value), ""))
+		}
+	}
+
+	if code == "" {
+		return nil
+	}
+
+	lang, ok := GetLanguage(code)
+	if alphabet == "" {
+		if ok {
+			return lang
+		}
+		// Unknown language without an alphabet: assume A-Z
+		return &Language{Code: code, Name: code, Alphabet: []rune(basicLatin)}
+	}
+
+	custom := &Language{Code: code, Name: code, Alphabet: []rune(alphabet)}
+	if ok {
+		custom.Name = lang.Name
+		custom.Fold = lang.Fold
+	}
+	return custom
+}
+
+// SetActiveLanguage makes the language's alphabet the one guesses are validated against
+func SetActiveLanguage(lang *Language) {
+	utils.SetAlphabet(lang.Alphabet)
+}
+
+// Normalize uppercases a word and applies the language's letter folding
+func (l *Language) Normalize(word string) string {
+	word = strings.ToUpper(strings.TrimSpace(word))
+	if len(l.Fold) == 0 {
+		return word
+	}
+
+	var sb strings.Builder
+	for _, r := range word {
+		if folded, ok := l.Fold[r]; ok {
+			sb.WriteString(folded)
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// HasLetter reports whether a letter belongs to the language's alphabet
+func (l *Language) HasLetter(r rune) bool {
+	r = unicode.ToUpper(r)
+	for _, letter := range l.Alphabet {
+		if letter == r {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPlayable reports whether a normalized word only uses letters of the alphabet
+func (l *Language) IsPlayable(word string) bool {
+	if word == "" {
+		return false
+	}
+	for _, r := range word {
+		if !l.HasLetter(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,39 @@
+package game
+
+import (
+	"fmt"
+	"os"
+)
+
+// fileLock is an advisory lock shared by every hangman process on the machine
+type fileLock struct {
+	file *os.File
+}
+
+// lockFile waits for an exclusive lock on path, using a separate path.lock
+// file so the locked file itself can be replaced while the lock is held
+func lockFile(path string) (*fileLock, error) {
+	//nolint:gosec // G304: File path is controlled by the application
+	file, err := os.OpenFile(path+".lock", os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lock file: %w", err)
+	}
+
+	if err := lockHandle(file); err != nil {
+		_ = file.Close() //nolint:errcheck // The lock error is more useful
+		return nil, fmt.Errorf("failed to lock %s: %w", path, err)
+	}
+	return &fileLock{file: file}, nil
+}
+
+// Unlock releases the lock
+func (l *fileLock) Unlock() error {
+	err := unlockHandle(l.file)
+	if closeErr := l.file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to unlock: %w", err)
+	}
+	return nil
+}
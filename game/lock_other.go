@@ -0,0 +1,15 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !windows
+
+package game
+
+import "os"
+
+// lockHandle does nothing on platforms without file locking
+func lockHandle(_ *os.File) error {
+	return nil
+}
+
+// unlockHandle does nothing on platforms without file locking
+func unlockHandle(_ *os.File) error {
+	return nil
+}
@@ -0,0 +1,40 @@
+//go:build windows
+
+package game
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	kernel32         = syscall.NewLazyDLL("kernel32.dll")
+	procLockFileEx   = kernel32.NewProc("LockFileEx")
+	procUnlockFileEx = kernel32.NewProc("UnlockFileEx")
+)
+
+// lockfileExclusiveLock is LOCKFILE_EXCLUSIVE_LOCK from the Windows API
+const lockfileExclusiveLock = 0x2
+
+// lockHandle takes an exclusive lock on the first byte of the file, waiting for other holders
+func lockHandle(file *os.File) error {
+	var overlapped syscall.Overlapped
+	r, _, err := procLockFileEx.Call(file.Fd(), lockfileExclusiveLock, 0, 1, 0,
+		uintptr(unsafe.Pointer(&overlapped))) //nolint:gosec // G103: Required by the Windows API
+	if r == 0 {
+		return err
+	}
+	return nil
+}
+
+// unlockHandle releases the lock
+func unlockHandle(file *os.File) error {
+	var overlapped syscall.Overlapped
+	r, _, err := procUnlockFileEx.Call(file.Fd(), 0, 1, 0,
+		uintptr(unsafe.Pointer(&overlapped))) //nolint:gosec // G103: Required by the Windows API
+	if r == 0 {
+		return err
+	}
+	return nil
+}
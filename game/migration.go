@@ -0,0 +1,117 @@
+package game
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// StatsVersion is the version of the stats.json format written by this build
+const StatsVersion = 3
+
+// legacyStatsVersion is assumed for files written before the version field existed
+const legacyStatsVersion = 1
+
+// breakdownStatsVersion is the first version with results per difficulty and category
+const breakdownStatsVersion = 3
+
+// ErrNewerVersion is returned for files written by a newer version of the game
+var ErrNewerVersion = errors.New("file is from a newer version of hangman")
+
+// errCorruptStats marks statistics files that can't be parsed
+var errCorruptStats = errors.New("statistics file is corrupt")
+
+// statsMigration upgrades the fields of a stats file by one version
+type statsMigration func(fields map[string]json.RawMessage) error
+
+// statsMigrations maps each version to the step that upgrades it to the next one
+var statsMigrations = map[int]statsMigration{
+	1: migrateStatsV1,
+	2: migrateStatsV2,
+}
+
+// migrateStatistics upgrades stats.json contents to StatsVersion. It returns
+// the upgraded contents and the version the data started at.
+func migrateStatistics(data []byte) ([]byte, int, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, 0, fmt.Errorf("failed to parse statistics: %v: %w", err, errCorruptStats)
+	}
+
+	version := legacyStatsVersion
+	if raw, ok := fields["version"]; ok {
+		if err := json.Unmarshal(raw, &version); err != nil {
+			return nil, 0, fmt.Errorf("invalid statistics version: %v: %w", err, errCorruptStats)
+		}
+	}
+	from := version
+
+	if version > StatsVersion {
+		return nil, from, fmt.Errorf("statistics are version %d but this build reads up to version %d: %w",
+			version, StatsVersion, ErrNewerVersion)
+	}
+	if version == StatsVersion {
+		return data, from, nil
+	}
+
+	for ; version < StatsVersion; version++ {
+		// A file that can't be upgraded is as unreadable as a corrupt one
+		migrate, ok := statsMigrations[version]
+		if !ok {
+			return nil, from, fmt.Errorf("no migration from statistics version %d: %w", version, errCorruptStats)
+		}
+		if err := migrate(fields); err != nil {
+			return nil, from, fmt.Errorf("failed to migrate statistics from version %d: %v: %w", version, err, errCorruptStats)
+		}
+	}
+
+	fields["version"] = json.RawMessage(fmt.Sprint(StatsVersion))
+	migrated, err := json.MarshalIndent(fields, "", "  ")
+	if err != nil {
+		return nil, from, fmt.Errorf("failed to marshal migrated statistics: %w", err)
+	}
+	return migrated, from, nil
+}
+
+// migrateStatsV1 upgrades unversioned files: maps and lists that older
+// builds left out or wrote as null become empty, and per-source counts start empty
+func migrateStatsV1(fields map[string]json.RawMessage) error {
+	for _, key := range []string{"difficulties", "sources"} {
+		if isMissing(fields[key]) {
+			fields[key] = json.RawMessage("{}")
+		}
+	}
+	if isMissing(fields["words_guessed"]) {
+		fields["words_guessed"] = json.RawMessage("[]")
+	}
+	if isMissing(fields["best_game"]) {
+		fields["best_game"] = json.RawMessage("6")
+	}
+	return nil
+}
+
+// migrateStatsV2 adds the empty per-difficulty and per-category results,
+// which are then rebuilt from the game history
+func migrateStatsV2(fields map[string]json.RawMessage) error {
+	for _, key := range []string{"by_difficulty", "by_category"} {
+		if isMissing(fields[key]) {
+			fields[key] = json.RawMessage("{}")
+		}
+	}
+	return nil
+}
+
+// isMissing reports whether a JSON field is absent or null
+func isMissing(raw json.RawMessage) bool {
+	return len(raw) == 0 || string(raw) == "null"
+}
+
+// backupFile copies the original contents of a file next to it before it is
+// upgraded, as <name>.v<version>.bak
+func backupFile(path string, data []byte, version int) (string, error) {
+	backup := fmt.Sprintf("%s.v%d.bak", path, version)
+	if err := writeFileAtomic(backup, data, 0o600); err != nil {
+		return "", fmt.Errorf("failed to back up %s: %w", path, err)
+	}
+	return backup, nil
+}
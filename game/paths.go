@@ -0,0 +1,79 @@
+package game
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Environment variables consulted when locating the data directory
+const (
+	HomeEnv       = "HANGMAN_HOME"    // Overrides the data and config directory
+	xdgDataEnv    = "XDG_DATA_HOME"   // Base directory for data files
+	xdgConfigEnv  = "XDG_CONFIG_HOME" // Base directory for config files
+	appDirName    = "hangman"         // Directory name under the XDG base directories
+	legacyDirName = ".hangman"        // Directory name in the home directory
+)
+
+// dataDirOverride is set from the --data-dir flag
+var dataDirOverride string
+
+// SetDataDir makes every game file live in dir, taking precedence over the
+// environment. An empty dir restores the default lookup.
+func SetDataDir(dir string) {
+	dataDirOverride = dir
+}
+
+// DataDir returns the directory where per-user game files such as statistics,
+// history and custom words are kept. It is the first of:
+//   - the directory given to SetDataDir (--data-dir)
+//   - $HANGMAN_HOME
+//   - ~/.hangman, when it already exists
+//   - $XDG_DATA_HOME/hangman
+//   - ~/.hangman
+func DataDir() (string, error) {
+	if dir := explicitDir(); dir != "" {
+		return dir, nil
+	}
+	return xdgDir(xdgDataEnv)
+}
+
+// ConfigDir returns the directory holding config.json. It is resolved like
+// DataDir, except that $XDG_CONFIG_HOME/hangman is used in place of
+// $XDG_DATA_HOME/hangman.
+func ConfigDir() (string, error) {
+	if dir := explicitDir(); dir != "" {
+		return dir, nil
+	}
+	return xdgDir(xdgConfigEnv)
+}
+
+// explicitDir returns the directory chosen on the command line or with HANGMAN_HOME
+func explicitDir() string {
+	if dataDirOverride != "" {
+		return dataDirOverride
+	}
+	return os.Getenv(HomeEnv)
+}
+
+// xdgDir returns the directory under the XDG base directory named by env. An
+// existing ~/.hangman wins so files saved by earlier versions are still found.
+func xdgDir(env string) (string, error) {
+	homeDir, homeErr := os.UserHomeDir()
+	if homeErr == nil {
+		legacy := filepath.Join(homeDir, legacyDirName)
+		if info, err := os.Stat(legacy); err == nil && info.IsDir() {
+			return legacy, nil
+		}
+	}
+
+	// The XDG specification says relative paths are invalid and must be ignored
+	if base := os.Getenv(env); filepath.IsAbs(base) {
+		return filepath.Join(base, appDirName), nil
+	}
+
+	if homeErr != nil {
+		return "", fmt.Errorf("failed to locate home directory: %w", homeErr)
+	}
+	return filepath.Join(homeDir, legacyDirName), nil
+}
@@ -0,0 +1,134 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultProfile is the profile used until another one is chosen. Its files
+// live directly in the data directory, where earlier versions kept them.
+const DefaultProfile = "default"
+
+// ProfilesDir is the directory in the data directory holding the other profiles
+const ProfilesDir = "profiles"
+
+// maxProfileNameLength is the longest allowed profile name, in characters
+const maxProfileNameLength = 32
+
+// ErrInvalidProfileName is returned for names that can't be used as a directory
+var ErrInvalidProfileName = errors.New("profile names must be 1-32 letters, digits, '-' or '_'")
+
+// activeProfile is the profile whose statistics, history and words are used
+var activeProfile = DefaultProfile
+
+// ValidateProfileName checks that a profile name is safe to use as a directory name
+func ValidateProfileName(name string) error {
+	length := utf8.RuneCountInString(name)
+	if length == 0 || length > maxProfileNameLength {
+		return ErrInvalidProfileName
+	}
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' {
+			return ErrInvalidProfileName
+		}
+	}
+	return nil
+}
+
+// SetProfile switches to a profile, creating its directory if needed
+func SetProfile(name string) error {
+	if err := CreateProfile(name); err != nil {
+		return err
+	}
+	activeProfile = name
+	return nil
+}
+
+// ActiveProfile returns the name of the profile in use
+func ActiveProfile() string {
+	return activeProfile
+}
+
+// CreateProfile creates the directory for a profile if it doesn't exist yet
+func CreateProfile(name string) error {
+	if err := ValidateProfileName(name); err != nil {
+		return err
+	}
+	dir, err := profileDir(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create profile directory: %w", err)
+	}
+	return nil
+}
+
+// ListProfiles returns the default profile followed by every other profile, sorted
+func ListProfiles() ([]string, error) {
+	profiles := []string{DefaultProfile}
+
+	dataDir, err := DataDir()
+	if err != nil {
+		return profiles, err
+	}
+
+	entries, err := os.ReadDir(filepath.Join(dataDir, ProfilesDir))
+	if os.IsNotExist(err) {
+		return profiles, nil
+	}
+	if err != nil {
+		return profiles, fmt.Errorf("failed to list profiles: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() && entry.Name() != DefaultProfile && ValidateProfileName(entry.Name()) == nil {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return strings.ToLower(names[i]) < strings.ToLower(names[j])
+	})
+	return append(profiles, names...), nil
+}
+
+// ProfileDir returns the directory holding the active profile's statistics,
+// history and custom words
+func ProfileDir() (string, error) {
+	return profileDir(activeProfile)
+}
+
+// profileDir returns the directory of a profile
+func profileDir(name string) (string, error) {
+	dataDir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+	if name == DefaultProfile {
+		return dataDir, nil
+	}
+	return filepath.Join(dataDir, ProfilesDir, name), nil
+}
+
+// LoadProfileStatistics loads the statistics of any profile, such as for
+// comparing players. Unlike LoadStatistics it leaves the profile's files
+// alone: nothing is migrated, backed up or recovered on disk.
+func LoadProfileStatistics(name string) (*Statistics, error) {
+	dir, err := profileDir(name)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := peekStatistics(filepath.Join(dir, StatsFile), filepath.Join(dir, HistoryFile))
+	if err != nil {
+		return nil, err
+	}
+	stats.store = NewDirStore("", name)
+	return stats, nil
+}
@@ -0,0 +1,275 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// RatingsFile is the name of the shared ratings file in the data directory
+const RatingsFile = "ratings.json"
+
+// Rating parameters. Players and words start at the same rating, and ratings
+// move faster while they are provisional.
+const (
+	InitialRating     = 1500.0
+	provisionalGames  = 20   // Games before a rating settles
+	provisionalFactor = 40.0 // K-factor while provisional
+	settledFactor     = 20.0 // K-factor once settled
+	ratingScale       = 400.0
+)
+
+// Rating is an Elo-style rating with the number of games it is based on
+type Rating struct {
+	Rating float64 `json:"rating"`
+	Games  int     `json:"games"`
+}
+
+// kFactor returns how far a single game can move the rating
+func (r Rating) kFactor() float64 {
+	if r.Games < provisionalGames {
+		return provisionalFactor
+	}
+	return settledFactor
+}
+
+// Ratings holds the rating of every profile and every word played. Each game
+// is scored as a match between the player and the word: solving the word is a
+// win for the player, failing is a win for the word.
+type Ratings struct {
+	Players map[string]*Rating `json:"players"` // Profile name -> rating
+	Words   map[string]*Rating `json:"words"`   // Word -> rating
+}
+
+// RatingChange describes how a game moved the player's and the word's ratings
+type RatingChange struct {
+	PlayerBefore, PlayerAfter float64
+	WordBefore, WordAfter     float64
+}
+
+// NewRatings creates empty ratings
+func NewRatings() *Ratings {
+	return &Ratings{
+		Players: make(map[string]*Rating),
+		Words:   make(map[string]*Rating),
+	}
+}
+
+// LoadRatings loads the ratings shared by all profiles from the current
+// store, see SetStatsStore
+func LoadRatings() (*Ratings, error) {
+	return currentStore().LoadRatings()
+}
+
+// readRatings reads a ratings file, returning empty ratings if it doesn't exist
+func readRatings(path string) (*Ratings, error) {
+	//nolint:gosec // G304: File path is controlled by the application
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return NewRatings(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ratings file: %w", err)
+	}
+	return parseRatings(data)
+}
+
+// parseRatings decodes saved ratings, filling in missing maps
+func parseRatings(data []byte) (*Ratings, error) {
+	ratings := NewRatings()
+	if err := json.Unmarshal(data, ratings); err != nil {
+		return nil, fmt.Errorf("failed to parse ratings: %w", err)
+	}
+	if ratings.Players == nil {
+		ratings.Players = make(map[string]*Rating)
+	}
+	if ratings.Words == nil {
+		ratings.Words = make(map[string]*Rating)
+	}
+	return ratings, nil
+}
+
+// updateRatingsFile applies update to the ratings file at path, reading and
+// writing it under a lock so concurrent games aren't lost
+func updateRatingsFile(path string, update func(*Ratings)) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create ratings directory: %w", err)
+	}
+
+	lock, err := lockFile(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = lock.Unlock() //nolint:errcheck // Ignore error on unlock in defer
+	}()
+
+	ratings, err := readRatings(path)
+	if err != nil {
+		return err
+	}
+	update(ratings)
+
+	data, err := json.MarshalIndent(ratings, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal ratings: %w", err)
+	}
+	if err := writeFileAtomic(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write ratings file: %w", err)
+	}
+	return nil
+}
+
+// RecordRatedGame updates the current store's ratings with a finished game of
+// the active profile
+func RecordRatedGame(entry HistoryEntry) (RatingChange, error) {
+	var change RatingChange
+	err := currentStore().UpdateRatings(func(ratings *Ratings) {
+		change = ratings.Record(ActiveProfile(), entry)
+	})
+	if err != nil {
+		return RatingChange{}, err
+	}
+	return change, nil
+}
+
+// Record scores a game between a profile and the word it played
+func (r *Ratings) Record(profile string, entry HistoryEntry) RatingChange {
+	player := ratingFor(r.Players, profile)
+	word := ratingFor(r.Words, strings.ToUpper(entry.Word))
+	change := RatingChange{PlayerBefore: player.Rating, WordBefore: word.Rating}
+
+	score := 0.0
+	if entry.Won() {
+		score = 1.0
+	}
+	expected := ExpectedScore(player.Rating, word.Rating)
+
+	player.Rating += player.kFactor() * (score - expected)
+	word.Rating += word.kFactor() * (expected - score)
+	player.Games++
+	word.Games++
+
+	change.PlayerAfter, change.WordAfter = player.Rating, word.Rating
+	return change
+}
+
+// ExpectedScore returns the chance of a player rated player solving a word rated word
+func ExpectedScore(player, word float64) float64 {
+	return 1 / (1 + math.Pow(10, (word-player)/ratingScale))
+}
+
+// Player returns a profile's rating, InitialRating if it hasn't played a rated game
+func (r *Ratings) Player(profile string) Rating {
+	if rating := r.Players[profile]; rating != nil {
+		return *rating
+	}
+	return Rating{Rating: InitialRating}
+}
+
+// Word returns a word's rating, InitialRating if it hasn't been played
+func (r *Ratings) Word(word string) Rating {
+	if rating := r.Words[strings.ToUpper(word)]; rating != nil {
+		return *rating
+	}
+	return Rating{Rating: InitialRating}
+}
+
+// HardestWords returns up to n played words with the highest ratings
+func (r *Ratings) HardestWords(n int) []string {
+	words := make([]string, 0, len(r.Words))
+	for word := range r.Words {
+		words = append(words, word)
+	}
+	sort.Slice(words, func(i, j int) bool {
+		a, b := r.Words[words[i]].Rating, r.Words[words[j]].Rating
+		if a != b {
+			return a > b
+		}
+		return words[i] < words[j]
+	})
+	if len(words) > n {
+		words = words[:n]
+	}
+	return words
+}
+
+// ratingFor returns the rating for a name, adding it at InitialRating if needed
+func ratingFor(ratings map[string]*Rating, name string) *Rating {
+	rating := ratings[name]
+	if rating == nil {
+		rating = &Rating{Rating: InitialRating}
+		ratings[name] = rating
+	}
+	return rating
+}
+
+// LeaderboardEntry is one profile's place on the leaderboard
+type LeaderboardEntry struct {
+	Profile       string
+	Rating        Rating
+	WinRate       float64
+	CurrentStreak int
+	LongestStreak int
+}
+
+// Leaderboard ranks every profile by rating, then win rate, then current
+// streak. Profiles whose statistics can't be loaded are left out and returned
+// as errors next to the ranking.
+func Leaderboard() ([]LeaderboardEntry, []error, error) {
+	ratings, err := LoadRatings()
+	if err != nil {
+		return nil, nil, err
+	}
+	profiles, err := ListProfiles()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entries := make([]LeaderboardEntry, 0, len(profiles))
+	var skipped []error
+	for _, profile := range profiles {
+		stats, err := LoadProfileStatistics(profile)
+		if err != nil {
+			skipped = append(skipped, fmt.Errorf("failed to load statistics for %s: %w", profile, err))
+			continue
+		}
+		entries = append(entries, LeaderboardEntry{
+			Profile:       profile,
+			Rating:        ratings.Player(profile),
+			WinRate:       stats.GetWinRate(),
+			CurrentStreak: stats.CurrentStreak,
+			LongestStreak: stats.LongestStreak,
+		})
+	}
+
+	RankLeaderboard(entries)
+	return entries, skipped, nil
+}
+
+// RankLeaderboard sorts entries by rating, then win rate, then current streak
+func RankLeaderboard(entries []LeaderboardEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Rating.Rating != b.Rating.Rating {
+			return a.Rating.Rating > b.Rating.Rating
+		}
+		if a.WinRate != b.WinRate {
+			return a.WinRate > b.WinRate
+		}
+		return a.CurrentStreak > b.CurrentStreak
+	})
+}
+
+// getRatingsFilePath returns the path to the ratings file
+func getRatingsFilePath() string {
+	dataDir, err := DataDir()
+	if err != nil {
+		return ".hangman_ratings.json" // Fallback to current directory
+	}
+	return filepath.Join(dataDir, RatingsFile)
+}
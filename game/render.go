@@ -0,0 +1,115 @@
+package game
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/VinayBhutange/hangman-go/utils"
+)
+
+// DefaultWidth is the screen width assumed when none is given
+const DefaultWidth = 80
+
+// Renderer draws the game's screens to a writer, such as the terminal, a
+// network connection or a buffer in tests
+type Renderer struct {
+	w          io.Writer
+	width      int
+	colors     utils.ColorLevel
+	art        *ArtPack
+	theme      utils.Theme
+	accessible bool // Describe the game in words for screen readers
+}
+
+// NewRenderer creates a renderer writing to w with the active art pack, color
+// theme and screen reader mode. Width is the number of columns available, DefaultWidth if zero
+// or less; colors is the color level to draw with, utils.ColorNone for plain text.
+func NewRenderer(w io.Writer, width int, colors utils.ColorLevel) *Renderer {
+	if width <= 0 {
+		width = DefaultWidth
+	}
+	return &Renderer{w: w, width: width, colors: colors, art: ActiveArtPack(), theme: utils.CurrentTheme(),
+		accessible: accessible}
+}
+
+// Stdout returns a renderer for the terminal, with as many colors as it supports
+func Stdout() *Renderer {
+	return NewRenderer(os.Stdout, DefaultWidth, utils.Colors())
+}
+
+// Width returns the number of columns the renderer draws in
+func (r *Renderer) Width() int {
+	return r.width
+}
+
+// Color reports whether the renderer uses ANSI colors
+func (r *Renderer) Color() bool {
+	return r.colors != utils.ColorNone
+}
+
+// println writes its arguments followed by a newline
+func (r *Renderer) println(a ...interface{}) {
+	fmt.Fprintln(r.w, a...)
+}
+
+// printf writes formatted output
+func (r *Renderer) printf(format string, a ...interface{}) {
+	fmt.Fprintf(r.w, format, a...)
+}
+
+// title writes a heading with an underline underline characters long, cut to
+// the screen width. Screen readers would read the underline out, so it is left
+// out in screen reader mode.
+func (r *Renderer) title(text string, underline int) {
+	if r.accessible {
+		r.println(text)
+		return
+	}
+	if underline > r.width {
+		underline = r.width
+	}
+	r.println(text)
+	r.println(strings.Repeat("=", underline))
+}
+
+// paint wraps text in the ANSI code for a theme color at the renderer's color
+// level, when colors are enabled and the theme has one
+func (r *Renderer) paint(text string, color utils.Color) string {
+	code := color.Code(r.colors)
+	if code == "" {
+		return text
+	}
+	return code + text + utils.ColorReset
+}
+
+// red returns red text
+func (r *Renderer) red(text string) string {
+	return r.paint(text, r.theme.Red)
+}
+
+// green returns green text
+func (r *Renderer) green(text string) string {
+	return r.paint(text, r.theme.Green)
+}
+
+// yellow returns yellow text
+func (r *Renderer) yellow(text string) string {
+	return r.paint(text, r.theme.Yellow)
+}
+
+// blue returns blue text
+func (r *Renderer) blue(text string) string {
+	return r.paint(text, r.theme.Blue)
+}
+
+// cyan returns cyan text
+func (r *Renderer) cyan(text string) string {
+	return r.paint(text, r.theme.Cyan)
+}
+
+// bold returns bold text
+func (r *Renderer) bold(text string) string {
+	return r.paint(text, r.theme.Bold)
+}
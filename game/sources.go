@@ -0,0 +1,142 @@
+package game
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// CustomSource is the tag given to words added during play
+const CustomSource = "custom"
+
+// WordSource is a named set of words loaded from a single file
+type WordSource struct {
+	Name     string   // Tag identifying the source, derived from the file name
+	Path     string   // File the words were loaded from, empty for built-in sources
+	Language string   // Language code declared by the word pack, empty if none
+	Words    []string // Words provided by this source
+	Enabled  bool     // Whether the words are included in the merged list
+}
+
+// NewWordSource creates an enabled source from a loaded word list
+func NewWordSource(name, path string, wl *WordList) *WordSource {
+	source := &WordSource{
+		Name:    name,
+		Path:    path,
+		Words:   wl.Words,
+		Enabled: true,
+	}
+	if wl.Language != nil {
+		source.Language = wl.Language.Code
+	}
+	return source
+}
+
+// SourceName derives a source tag from a file name
+func SourceName(filename string) string {
+	base := filepath.Base(filename)
+	return strings.ToLower(strings.TrimSuffix(base, filepath.Ext(base)))
+}
+
+// LoadWordSources loads every .txt file in a directory as a separate source
+func LoadWordSources(dir string) ([]*WordSource, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.txt"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list word directory: %w", err)
+	}
+	if len(matches) == 0 {
+		if _, err := os.Stat(dir); err != nil {
+			return nil, fmt.Errorf("failed to open word directory: %w", err)
+		}
+	}
+	sort.Strings(matches)
+
+	sources := make([]*WordSource, 0, len(matches))
+	for _, path := range matches {
+		wl, err := LoadWordsFromFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+		}
+		sources = append(sources, NewWordSource(SourceName(path), path, wl))
+	}
+
+	return sources, nil
+}
+
+// MergeWordSources merges the enabled sources into one word list. A word that
+// appears in several sources is kept once and tagged with the first source.
+func MergeWordSources(sources []*WordSource) *WordList {
+	wl := &WordList{Sources: sources}
+	wl.rebuild()
+	return wl
+}
+
+// SourceOf returns the tag of the source a word came from, or "" if the list has no sources
+func (wl *WordList) SourceOf(word string) string {
+	return wl.sourceOf[strings.ToUpper(word)]
+}
+
+// GetSource returns the source with the given name, or nil if there is none
+func (wl *WordList) GetSource(name string) *WordSource {
+	for _, source := range wl.Sources {
+		if source.Name == name {
+			return source
+		}
+	}
+	return nil
+}
+
+// SetSourceEnabled includes or excludes a source's words from the list
+func (wl *WordList) SetSourceEnabled(name string, enabled bool) error {
+	source := wl.GetSource(name)
+	if source == nil {
+		return fmt.Errorf("unknown word source: %s", name)
+	}
+
+	source.Enabled = enabled
+	wl.rebuild()
+	return nil
+}
+
+// speaks reports whether a source's words belong to the list's language
+func (wl *WordList) speaks(source *WordSource) bool {
+	return wl.Language == nil || source.Language == "" || source.Language == wl.Language.Code
+}
+
+// rebuild recomputes Words and the source tags from the enabled sources, leaving
+// out blocked words and words that can't be played in the list's language
+func (wl *WordList) rebuild() {
+	wl.Words = nil
+	wl.filtered = nil
+	wl.unplayable = nil
+	wl.sourceOf = make(map[string]string)
+	wl.index = nil
+
+	for _, source := range wl.Sources {
+		if !source.Enabled || !wl.speaks(source) {
+			continue
+		}
+		for _, word := range source.Words {
+			if wl.Language != nil {
+				word = wl.Language.Normalize(word)
+			}
+			if _, ok := wl.sourceOf[word]; ok {
+				continue
+			}
+			if wl.Language != nil && !wl.Language.IsPlayable(word) {
+				wl.sourceOf[word] = "" // Seen, but not playable
+				wl.unplayable = append(wl.unplayable, word)
+				continue
+			}
+			if wl.blocklist.Contains(word) {
+				wl.sourceOf[word] = "" // Seen, but not playable
+				wl.filtered = append(wl.filtered, word)
+				continue
+			}
+			wl.sourceOf[word] = source.Name
+			wl.Words = append(wl.Words, word)
+		}
+	}
+}
@@ -2,14 +2,22 @@ package game
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"time"
+	"unicode/utf8"
+
+	"github.com/VinayBhutange/hangman-go/i18n"
 )
 
+// StatsFile is the name of the statistics file in the profile directory
+const StatsFile = "stats.json"
+
 // Statistics represents game statistics
 type Statistics struct {
+	Version        int            `json:"version"` // Format version, see StatsVersion
 	GamesPlayed    int            `json:"games_played"`
 	GamesWon       int            `json:"games_won"`
 	GamesLost      int            `json:"games_lost"`
@@ -22,95 +30,282 @@ type Statistics struct {
 	LastPlayed     time.Time      `json:"last_played"`
 	WordsGuessed   []string       `json:"words_guessed"` // Recently guessed words
 	Difficulties   map[string]int `json:"difficulties"`  // Games played per difficulty
+	Sources        map[string]int `json:"sources"`       // Games played per word source
+
+	ByDifficulty map[string]*Breakdown `json:"by_difficulty"` // Results per difficulty
+	ByCategory   map[string]*Breakdown `json:"by_category"`   // Results per word source
+
+	RecoveredFrom string `json:"-"` // Backup the statistics were loaded from because stats.json was corrupt
+
+	pendingHistory  []HistoryEntry  // Games recorded since the last save
+	pendingImports  []pendingImport // Statistics imported since the last save
+	importedHistory []HistoryEntry  // Games the pending imports added, as of the import
+	replace         bool            // Set by ResetStatistics so the next save replaces the file
+	store           StatsStore      // Where the statistics were loaded from, nil for the current store
 }
 
 // NewStatistics creates a new statistics instance
 func NewStatistics() *Statistics {
 	return &Statistics{
+		Version:      StatsVersion,
 		WordsGuessed: make([]string, 0),
 		Difficulties: make(map[string]int),
+		Sources:      make(map[string]int),
+		ByDifficulty: make(map[string]*Breakdown),
+		ByCategory:   make(map[string]*Breakdown),
 		BestGame:     6, // Start with worst possible score
 	}
 }
 
-// LoadStatistics loads statistics from file
+// LoadStatistics loads the active profile's statistics from the current store,
+// see SetStatsStore. A corrupt file is moved aside to stats.json.corrupt and the
+// last good backup is loaded instead, with RecoveredFrom set to the backup's path.
 func LoadStatistics() (*Statistics, error) {
-	statsFile := getStatsFilePath()
+	return currentStore().Load()
+}
 
+// loadStatistics loads the statistics file at statsFile, rebuilding anything
+// older versions didn't keep from the history log at historyFile
+func loadStatistics(statsFile, historyFile string) (*Statistics, error) {
 	// If file doesn't exist, return new statistics
 	if _, err := os.Stat(statsFile); os.IsNotExist(err) {
 		return NewStatistics(), nil
 	}
 
+	lock, err := lockFile(statsFile)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = lock.Unlock() //nolint:errcheck // Ignore error on unlock in defer
+	}()
+
+	stats, data, version, err := readStatistics(statsFile)
+	if errors.Is(err, errCorruptStats) {
+		return recoverStatistics(statsFile, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Upgrade files written by older versions, keeping a copy of the original
+	if version != StatsVersion {
+		if version < breakdownStatsVersion {
+			// Results per difficulty and category weren't kept; rebuild them from the games on record
+			history, err := readHistoryFile(historyFile)
+			if err != nil {
+				return nil, err
+			}
+			stats.rebuildBreakdowns(history)
+		}
+		if _, err := backupFile(statsFile, data, version); err != nil {
+			return nil, err
+		}
+		if err := stats.write(statsFile); err != nil {
+			return nil, fmt.Errorf("failed to write migrated statistics: %w", err)
+		}
+	}
+
+	return stats, nil
+}
+
+// peekStatistics reads the statistics file at statsFile without changing
+// anything on disk: older versions are upgraded in memory only, and corrupt
+// files are reported rather than moved aside
+func peekStatistics(statsFile, historyFile string) (*Statistics, error) {
+	stats, _, version, err := readStatistics(statsFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return NewStatistics(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if version < breakdownStatsVersion {
+		history, err := readHistoryFile(historyFile)
+		if err != nil {
+			return nil, err
+		}
+		stats.rebuildBreakdowns(history)
+	}
+	return stats, nil
+}
+
+// readStatistics reads and migrates a statistics file, returning the original
+// contents and the version they were written in
+func readStatistics(path string) (*Statistics, []byte, int, error) {
 	//nolint:gosec // G304: File path is controlled by the application
-	data, err := os.ReadFile(statsFile)
+	data, err := os.ReadFile(path)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read statistics file: %w", err)
+		return nil, nil, 0, fmt.Errorf("failed to read statistics file: %w", err)
 	}
 
-	var stats Statistics
-	if err := json.Unmarshal(data, &stats); err != nil {
-		return nil, fmt.Errorf("failed to parse statistics: %w", err)
+	migrated, version, err := migrateStatistics(data)
+	if err != nil {
+		return nil, data, version, err
 	}
 
-	// Initialize maps if they're nil (for backward compatibility)
-	if stats.Difficulties == nil {
-		stats.Difficulties = make(map[string]int)
+	stats := NewStatistics()
+	if err := json.Unmarshal(migrated, stats); err != nil {
+		return nil, data, version, fmt.Errorf("failed to parse statistics: %v: %w", err, errCorruptStats)
 	}
-	if stats.WordsGuessed == nil {
-		stats.WordsGuessed = make([]string, 0)
+
+	return stats, data, version, nil
+}
+
+// recoverStatistics moves a corrupt statistics file aside and loads the last good backup
+func recoverStatistics(statsFile string, cause error) (*Statistics, error) {
+	corruptFile := statsFile + ".corrupt"
+	if err := os.Rename(statsFile, corruptFile); err != nil {
+		return nil, fmt.Errorf("failed to move corrupt statistics aside: %w", err)
 	}
 
-	return &stats, nil
+	backupFile := statsFile + ".bak"
+	stats, _, _, err := readStatistics(backupFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w (moved to %s, and no good backup was found)", cause, corruptFile)
+	}
+
+	stats.RecoveredFrom = backupFile
+	return stats, nil
 }
 
-// SaveStatistics saves statistics to file
+// SaveStatistics saves statistics to the store they were loaded from, or the
+// current store for new statistics. Games recorded since the last load or save
+// are merged into whatever another hangman process may have saved in the
+// meantime, so no games are lost.
 func (s *Statistics) SaveStatistics() error {
-	statsFile := getStatsFilePath()
+	return s.statsStore().Save(s)
+}
 
+// saveStatistics saves statistics to statsFile, appending the games recorded
+// since the last save to historyFile
+func saveStatistics(s *Statistics, statsFile, historyFile string) error {
 	// Create directory if it doesn't exist
 	dir := filepath.Dir(statsFile)
 	if err := os.MkdirAll(dir, 0o750); err != nil { // More restrictive permissions
 		return fmt.Errorf("failed to create stats directory: %w", err)
 	}
 
-	data, err := json.MarshalIndent(s, "", "  ")
+	lock, err := lockFile(statsFile)
 	if err != nil {
-		return fmt.Errorf("failed to marshal statistics: %w", err)
+		return err
+	}
+	defer func() {
+		_ = lock.Unlock() //nolint:errcheck // Ignore error on unlock in defer
+	}()
+
+	disk, data, _, err := readStatistics(statsFile)
+	switch {
+	case err == nil:
+		// Keep the file being replaced as the last good backup
+		if err := writeFileAtomic(statsFile+".bak", data, 0o600); err != nil {
+			return fmt.Errorf("failed to back up statistics: %w", err)
+		}
+	case errors.Is(err, ErrNewerVersion):
+		return err
+	case errors.Is(err, errCorruptStats), errors.Is(err, os.ErrNotExist):
+		// Nothing worth merging; this process's statistics replace the file
+		disk = nil
+	default:
+		return err
 	}
 
-	// Write to file with restricted permissions
-	if err := os.WriteFile(statsFile, data, 0o600); err != nil { // More restrictive permissions
+	merged, appended, err := s.mergeSaved(disk, func() ([]HistoryEntry, error) {
+		return readHistoryFile(historyFile)
+	})
+	if err != nil {
+		return err
+	}
+	if err := merged.write(statsFile); err != nil {
 		return fmt.Errorf("failed to write statistics file: %w", err)
 	}
 
+	// Log the games recorded and imported since the last save
+	if err := appendHistoryFile(historyFile, appended...); err != nil {
+		return err
+	}
+
+	s.saved(merged)
 	return nil
 }
 
-// RecordGame records the results of a completed game
-func (s *Statistics) RecordGame(g *Game, difficulty string) {
+// saved takes over the statistics that were just saved, which include games
+// other processes saved since s was loaded, and clears the pending games
+func (s *Statistics) saved(merged *Statistics) {
+	if merged != s {
+		store := s.store
+		*s = *merged
+		s.store = store
+	}
+	s.pendingHistory = nil
+	s.pendingImports = nil
+	s.importedHistory = nil
+	s.replace = false
+}
+
+// statsStore returns the store the statistics were loaded from, or the
+// current store for statistics that weren't loaded
+func (s *Statistics) statsStore() StatsStore {
+	if s.store != nil {
+		return s.store
+	}
+	return currentStore()
+}
+
+// write replaces the statistics file atomically
+func (s *Statistics) write(path string) error {
+	s.Version = StatsVersion
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal statistics: %w", err)
+	}
+
+	// Write to file with restricted permissions
+	return writeFileAtomic(path, data, 0o600)
+}
+
+// RecordGame records the results of a completed game and returns it as it
+// will appear in the history
+func (s *Statistics) RecordGame(g *Game, difficulty string) HistoryEntry {
+	// Keep the full game for the history log, written on the next save
+	entry := NewHistoryEntry(g, difficulty)
+	s.pendingHistory = append(s.pendingHistory, entry)
+	s.apply(entry)
+	return entry
+}
+
+// apply adds a finished game to the statistics
+func (s *Statistics) apply(entry HistoryEntry) {
+	guesses := utf8.RuneCountInString(entry.Guesses)
 	s.GamesPlayed++
-	s.LastPlayed = time.Now()
-	s.TotalGuesses += len(g.GuessedLetters)
-	s.WrongGuesses += g.WrongGuesses
-	s.CorrectGuesses += len(g.GuessedLetters) - g.WrongGuesses
+	s.LastPlayed = entry.PlayedAt
+	s.TotalGuesses += guesses
+	s.WrongGuesses += entry.WrongGuesses
+	s.CorrectGuesses += guesses - entry.WrongGuesses
 
 	// Record difficulty
-	s.Difficulties[difficulty]++
+	s.Difficulties[entry.Difficulty]++
+
+	// Record word source
+	if entry.Category != "" {
+		s.Sources[entry.Category]++
+	}
+	s.recordBreakdowns(entry)
 
 	// Add word to recently guessed (keep last 10)
-	s.WordsGuessed = append(s.WordsGuessed, g.Word)
+	s.WordsGuessed = append(s.WordsGuessed, entry.Word)
 	if len(s.WordsGuessed) > 10 {
 		s.WordsGuessed = s.WordsGuessed[1:]
 	}
 
-	if g.IsWon {
+	if entry.Won() {
 		s.GamesWon++
 		s.CurrentStreak++
 
 		// Update best game (fewest wrong guesses)
-		if g.WrongGuesses < s.BestGame {
-			s.BestGame = g.WrongGuesses
+		if entry.WrongGuesses < s.BestGame {
+			s.BestGame = entry.WrongGuesses
 		}
 
 		// Update longest streak
@@ -149,53 +344,53 @@ func (s *Statistics) GetGuessAccuracy() float64 {
 
 // PrintStatistics prints formatted statistics
 func (s *Statistics) PrintStatistics() {
-	fmt.Println("📊 GAME STATISTICS")
-	fmt.Println("==================")
-	fmt.Printf("Games Played: %d\n", s.GamesPlayed)
-	fmt.Printf("Games Won: %d\n", s.GamesWon)
-	fmt.Printf("Games Lost: %d\n", s.GamesLost)
-	fmt.Printf("Win Rate: %.1f%%\n", s.GetWinRate())
-	fmt.Printf("Current Streak: %d\n", s.CurrentStreak)
-	fmt.Printf("Longest Streak: %d\n", s.LongestStreak)
+	Stdout().Statistics(s)
+}
+
+// Statistics draws the statistics screen
+func (r *Renderer) Statistics(s *Statistics) {
+	r.title(i18n.T("stats.title"), 18)
+	r.println(i18n.T("stats.games_played", s.GamesPlayed))
+	r.println(i18n.T("stats.games_won", s.GamesWon))
+	r.println(i18n.T("stats.games_lost", s.GamesLost))
+	r.println(i18n.T("stats.win_rate", s.GetWinRate()))
+	r.println(i18n.T("stats.current_streak", s.CurrentStreak))
+	r.println(i18n.T("stats.longest_streak", s.LongestStreak))
 
 	if s.GamesWon > 0 {
-		fmt.Printf("Best Game: %d wrong guesses\n", s.BestGame)
+		r.println(i18n.N("stats.best_game", s.BestGame))
 	}
 
-	fmt.Printf("Average Guesses: %.1f\n", s.GetAverageGuesses())
-	fmt.Printf("Guess Accuracy: %.1f%%\n", s.GetGuessAccuracy())
+	r.println(i18n.T("stats.average_guesses", s.GetAverageGuesses()))
+	r.println(i18n.T("stats.guess_accuracy", s.GetGuessAccuracy()))
 
-	if len(s.Difficulties) > 0 {
-		fmt.Println("\nGames by Difficulty:")
-		for difficulty, count := range s.Difficulties {
-			fmt.Printf("  %s: %d\n", difficulty, count)
-		}
+	if len(s.ByDifficulty) > 0 {
+		r.breakdowns(i18n.T("stats.by_difficulty"), difficultyOrder(s.ByDifficulty), s.ByDifficulty)
+	}
+
+	if len(s.ByCategory) > 0 {
+		r.breakdowns(i18n.T("stats.by_source"), categoryOrder(s.ByCategory), s.ByCategory)
 	}
 
 	if len(s.WordsGuessed) > 0 {
-		fmt.Println("\nRecently Guessed Words:")
+		r.println("\n" + i18n.T("stats.recent_words"))
 		for i := len(s.WordsGuessed) - 1; i >= 0 && i >= len(s.WordsGuessed)-5; i-- {
-			fmt.Printf("  %s\n", s.WordsGuessed[i])
+			r.printf("  %s\n", s.WordsGuessed[i])
 		}
 	}
 
 	if !s.LastPlayed.IsZero() {
-		fmt.Printf("\nLast Played: %s\n", s.LastPlayed.Format("2006-01-02 15:04:05"))
+		r.println("\n" + i18n.T("stats.last_played", s.LastPlayed.Format("2006-01-02 15:04:05")))
 	}
 
-	fmt.Println()
-}
-
-// getStatsFilePath returns the path to the statistics file
-func getStatsFilePath() string {
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return ".hangman_stats.json" // Fallback to current directory
-	}
-	return filepath.Join(homeDir, ".hangman", "stats.json")
+	r.println()
 }
 
-// ResetStatistics resets all statistics
+// ResetStatistics resets all statistics. Games not yet saved still go to the
+// history; imports not yet saved are dropped with the statistics they added to.
 func (s *Statistics) ResetStatistics() {
+	pending, store := s.pendingHistory, s.store
 	*s = *NewStatistics()
+	s.pendingHistory, s.store = pending, store
+	s.replace = true
 }
@@ -0,0 +1,331 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sync"
+)
+
+// StatsStore keeps a profile's statistics and game history, and the ratings
+// shared by all profiles
+type StatsStore interface {
+	// Load returns the saved statistics, or new statistics if there are none
+	Load() (*Statistics, error)
+	// Save saves statistics, merging the games recorded since they were loaded
+	// into whatever was saved in the meantime, and appends those games to the history
+	Save(s *Statistics) error
+	// LoadHistory returns every recorded game, oldest first
+	LoadHistory() ([]HistoryEntry, error)
+	// AppendHistory adds entries to the end of the history
+	AppendHistory(entries ...HistoryEntry) error
+	// PruneHistory drops games older than maxDays and all but the newest
+	// maxEntries games, returning how many were removed. Zero keeps everything.
+	PruneHistory(maxEntries, maxDays int) (int, error)
+	// LoadRatings returns the saved ratings, or empty ratings if there are none
+	LoadRatings() (*Ratings, error)
+	// UpdateRatings applies update to the saved ratings and saves them, without
+	// losing updates made at the same time
+	UpdateRatings(update func(*Ratings)) error
+}
+
+// statsStore is the store set with SetStatsStore, nil for the active profile's directory
+var statsStore StatsStore
+
+// SetStatsStore makes LoadStatistics, LoadHistory and the other package level
+// functions use store, such as a MemoryStore in tests. Nil goes back to the
+// active profile's directory in the data directory.
+func SetStatsStore(store StatsStore) {
+	statsStore = store
+}
+
+// currentStore returns the store set with SetStatsStore, or the active
+// profile's directory
+func currentStore() StatsStore {
+	if statsStore != nil {
+		return statsStore
+	}
+	return NewDirStore("", "")
+}
+
+// FileStore keeps statistics in a JSON file, with the history log and ratings
+// next to it
+type FileStore struct {
+	statsFile   string
+	historyFile string
+}
+
+// NewFileStore creates a store for the statistics file at path, keeping the
+// history in history.jsonl in the same directory
+func NewFileStore(path string) *FileStore {
+	return &FileStore{
+		statsFile:   path,
+		historyFile: filepath.Join(filepath.Dir(path), HistoryFile),
+	}
+}
+
+// Path returns the path of the statistics file
+func (f *FileStore) Path() string {
+	return f.statsFile
+}
+
+// Load loads the statistics file. A corrupt file is moved aside and the last
+// good backup is loaded instead, with RecoveredFrom set to the backup's path.
+func (f *FileStore) Load() (*Statistics, error) {
+	stats, err := loadStatistics(f.statsFile, f.historyFile)
+	if err != nil {
+		return nil, err
+	}
+	stats.store = f
+	return stats, nil
+}
+
+// Save saves statistics to the file under a lock, keeping the replaced file as
+// a backup
+func (f *FileStore) Save(s *Statistics) error {
+	return saveStatistics(s, f.statsFile, f.historyFile)
+}
+
+// LoadHistory reads the history log
+func (f *FileStore) LoadHistory() ([]HistoryEntry, error) {
+	return readHistoryFile(f.historyFile)
+}
+
+// AppendHistory adds entries to the end of the history log
+func (f *FileStore) AppendHistory(entries ...HistoryEntry) error {
+	return appendHistoryFile(f.historyFile, entries...)
+}
+
+// PruneHistory applies the retention settings to the history log
+func (f *FileStore) PruneHistory(maxEntries, maxDays int) (int, error) {
+	return pruneHistoryFile(f.historyFile, maxEntries, maxDays)
+}
+
+// LoadRatings reads the ratings file next to the statistics file
+func (f *FileStore) LoadRatings() (*Ratings, error) {
+	return readRatings(f.ratingsFile())
+}
+
+// UpdateRatings updates the ratings file next to the statistics file under a lock
+func (f *FileStore) UpdateRatings(update func(*Ratings)) error {
+	return updateRatingsFile(f.ratingsFile(), update)
+}
+
+// ratingsFile returns the path of the ratings file next to the statistics file
+func (f *FileStore) ratingsFile() string {
+	return filepath.Join(filepath.Dir(f.statsFile), RatingsFile)
+}
+
+// DirStore keeps each profile's statistics in its own directory: the default
+// profile's in the root and the others' in profiles/<name>. The ratings are
+// shared, in the root.
+type DirStore struct {
+	root    string
+	profile string
+}
+
+// NewDirStore creates a store for a profile under root. An empty root means
+// the data directory and an empty profile the active profile, both looked up
+// each time the store is used.
+func NewDirStore(root, profile string) *DirStore {
+	return &DirStore{root: root, profile: profile}
+}
+
+// Dir returns the directory of the store's profile
+func (d *DirStore) Dir() (string, error) {
+	profile := d.profile
+	if profile == "" {
+		profile = ActiveProfile()
+	}
+	if d.root == "" {
+		return profileDir(profile)
+	}
+	if profile == DefaultProfile {
+		return d.root, nil
+	}
+	return filepath.Join(d.root, ProfilesDir, profile), nil
+}
+
+// files returns the file store of the profile's directory
+func (d *DirStore) files() *FileStore {
+	dir, err := d.Dir()
+	if err != nil {
+		// Fallback to current directory
+		return &FileStore{statsFile: ".hangman_stats.json", historyFile: ".hangman_history.jsonl"}
+	}
+	return NewFileStore(filepath.Join(dir, StatsFile))
+}
+
+// Load loads the profile's statistics
+func (d *DirStore) Load() (*Statistics, error) {
+	stats, err := d.files().Load()
+	if err != nil {
+		return nil, err
+	}
+	stats.store = d
+	return stats, nil
+}
+
+// Save saves the profile's statistics
+func (d *DirStore) Save(s *Statistics) error {
+	return d.files().Save(s)
+}
+
+// LoadHistory reads the profile's history
+func (d *DirStore) LoadHistory() ([]HistoryEntry, error) {
+	return d.files().LoadHistory()
+}
+
+// AppendHistory adds entries to the end of the profile's history
+func (d *DirStore) AppendHistory(entries ...HistoryEntry) error {
+	return d.files().AppendHistory(entries...)
+}
+
+// PruneHistory applies the retention settings to the profile's history
+func (d *DirStore) PruneHistory(maxEntries, maxDays int) (int, error) {
+	return d.files().PruneHistory(maxEntries, maxDays)
+}
+
+// LoadRatings reads the ratings file in the root
+func (d *DirStore) LoadRatings() (*Ratings, error) {
+	return readRatings(d.ratingsFile())
+}
+
+// UpdateRatings updates the ratings file in the root under a lock
+func (d *DirStore) UpdateRatings(update func(*Ratings)) error {
+	return updateRatingsFile(d.ratingsFile(), update)
+}
+
+// ratingsFile returns the path of the ratings file in the root
+func (d *DirStore) ratingsFile() string {
+	if d.root == "" {
+		return getRatingsFilePath()
+	}
+	return filepath.Join(d.root, RatingsFile)
+}
+
+// MemoryStore keeps statistics, history and ratings in memory, for tests and for
+// players whose games shouldn't be written to disk
+type MemoryStore struct {
+	mu      sync.Mutex
+	stats   []byte // Saved statistics as JSON, so loaded copies don't share maps
+	history []HistoryEntry
+	ratings []byte // Saved ratings as JSON
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// Load returns a copy of the saved statistics
+func (m *MemoryStore) Load() (*Statistics, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.load()
+}
+
+// load decodes the saved statistics; the caller holds the lock
+func (m *MemoryStore) load() (*Statistics, error) {
+	stats := NewStatistics()
+	if m.stats != nil {
+		if err := json.Unmarshal(m.stats, stats); err != nil {
+			return nil, fmt.Errorf("failed to parse statistics: %w", err)
+		}
+	}
+	stats.store = m
+	return stats, nil
+}
+
+// Save merges the games recorded since s was loaded into the saved statistics
+func (m *MemoryStore) Save(s *Statistics) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var saved *Statistics
+	if m.stats != nil {
+		var err error
+		if saved, err = m.load(); err != nil {
+			return err
+		}
+	}
+	merged, appended, err := s.mergeSaved(saved, func() ([]HistoryEntry, error) {
+		return append([]HistoryEntry(nil), m.history...), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	merged.Version = StatsVersion
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return fmt.Errorf("failed to marshal statistics: %w", err)
+	}
+	m.stats = data
+	m.history = append(m.history, appended...)
+
+	s.saved(merged)
+	return nil
+}
+
+// LoadHistory returns a copy of the recorded games
+func (m *MemoryStore) LoadHistory() ([]HistoryEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.history) == 0 {
+		return nil, nil
+	}
+	return append([]HistoryEntry(nil), m.history...), nil
+}
+
+// AppendHistory adds entries to the end of the history
+func (m *MemoryStore) AppendHistory(entries ...HistoryEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.history = append(m.history, entries...)
+	return nil
+}
+
+// PruneHistory applies the retention settings to the history
+func (m *MemoryStore) PruneHistory(maxEntries, maxDays int) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	kept := pruneEntries(m.history, maxEntries, maxDays)
+	removed := len(m.history) - len(kept)
+	m.history = append([]HistoryEntry(nil), kept...)
+	return removed, nil
+}
+
+// LoadRatings returns a copy of the saved ratings
+func (m *MemoryStore) LoadRatings() (*Ratings, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.loadRatings()
+}
+
+// loadRatings decodes the saved ratings; the caller holds the lock
+func (m *MemoryStore) loadRatings() (*Ratings, error) {
+	if m.ratings == nil {
+		return NewRatings(), nil
+	}
+	return parseRatings(m.ratings)
+}
+
+// UpdateRatings applies update to the saved ratings
+func (m *MemoryStore) UpdateRatings(update func(*Ratings)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ratings, err := m.loadRatings()
+	if err != nil {
+		return err
+	}
+	update(ratings)
+
+	data, err := json.Marshal(ratings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal ratings: %w", err)
+	}
+	m.ratings = data
+	return nil
+}
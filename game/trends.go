@@ -0,0 +1,185 @@
+package game
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VinayBhutange/hangman-go/i18n"
+)
+
+// Period aggregates the games played in a day or a week
+type Period struct {
+	Start        time.Time // Midnight local time on the first day
+	Games        int
+	Won          int
+	WrongGuesses int
+}
+
+// WinRate returns the win rate for the period as a percentage
+func (p Period) WinRate() float64 {
+	if p.Games == 0 {
+		return 0.0
+	}
+	return float64(p.Won) / float64(p.Games) * 100
+}
+
+// AverageWrongGuesses returns the average number of wrong guesses per game
+func (p Period) AverageWrongGuesses() float64 {
+	if p.Games == 0 {
+		return 0.0
+	}
+	return float64(p.WrongGuesses) / float64(p.Games)
+}
+
+// DailyTrends aggregates the games of the last days days up to and including
+// now's day, oldest first. Days without games are included.
+func DailyTrends(history []HistoryEntry, days int, now time.Time) []Period {
+	return aggregate(history, startOfDay(now), days, 1)
+}
+
+// WeeklyTrends aggregates the games of the last weeks weeks up to and
+// including now's week, oldest first. Weeks start on Monday.
+func WeeklyTrends(history []HistoryEntry, weeks int, now time.Time) []Period {
+	today := startOfDay(now)
+	sinceMonday := (int(today.Weekday()) + 6) % 7
+	return aggregate(history, today.AddDate(0, 0, -sinceMonday), weeks, 7)
+}
+
+// aggregate buckets games into count periods of length days, the last one starting at last
+func aggregate(history []HistoryEntry, last time.Time, count, length int) []Period {
+	if count <= 0 {
+		return nil
+	}
+
+	periods := make([]Period, count)
+	for i := range periods {
+		periods[i].Start = last.AddDate(0, 0, -length*(count-1-i))
+	}
+	end := last.AddDate(0, 0, length)
+
+	for _, entry := range history {
+		played := entry.PlayedAt.Local()
+		if played.Before(periods[0].Start) || !played.Before(end) {
+			continue
+		}
+		// Walk back from the newest period; dates rather than durations keep DST days right
+		i := count - 1
+		for played.Before(periods[i].Start) {
+			i--
+		}
+		periods[i].Games++
+		periods[i].WrongGuesses += entry.WrongGuesses
+		if entry.Won() {
+			periods[i].Won++
+		}
+	}
+	return periods
+}
+
+// startOfDay returns midnight local time on t's day
+func startOfDay(t time.Time) time.Time {
+	t = t.Local()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// WrongGuessDistribution counts won games by their number of wrong guesses;
+// index i holds the wins with i wrong guesses. Games with more wrong guesses
+// than they allowed, or fewer than none, are left out.
+func WrongGuessDistribution(history []HistoryEntry) []int {
+	size := 6 // Default number of wrong guesses allowed
+	for _, entry := range history {
+		if entry.Won() && entry.wrongInRange() && entry.WrongGuesses >= size {
+			size = entry.WrongGuesses + 1
+		}
+	}
+
+	counts := make([]int, size)
+	for _, entry := range history {
+		if entry.Won() && entry.wrongInRange() {
+			counts[entry.WrongGuesses]++
+		}
+	}
+	return counts
+}
+
+// PrintTrends prints weekly aggregates with sparklines over the last weeks
+// weeks, a daily activity sparkline and the wrong guess distribution of won games
+func PrintTrends(history []HistoryEntry, weeks int, now time.Time) {
+	Stdout().Trends(history, weeks, now)
+}
+
+// Trends draws the trends screen, see PrintTrends
+func (r *Renderer) Trends(history []HistoryEntry, weeks int, now time.Time) {
+	if r.accessible {
+		r.describeTrends(history, weeks, now)
+		return
+	}
+
+	r.println(i18n.T("trends.title"))
+	r.println("===========")
+	if len(history) == 0 {
+		r.println(i18n.T("analytics.empty"))
+		r.println()
+		return
+	}
+
+	weekly := WeeklyTrends(history, weeks, now)
+	games := make([]float64, len(weekly))
+	winRates := make([]float64, len(weekly))
+	wrong := make([]float64, len(weekly))
+	for i, week := range weekly {
+		games[i] = float64(week.Games)
+		winRates[i] = week.WinRate()
+		wrong[i] = week.AverageWrongGuesses()
+	}
+
+	r.println("\n" + i18n.N("trends.weeks", weeks))
+	r.printf("  %-16s %s\n", i18n.T("trends.games"), Sparkline(games))
+	r.printf("  %-16s %s\n", i18n.T("trends.win_rate"), Sparkline(winRates))
+	r.printf("  %-16s %s\n", i18n.T("trends.avg_wrong"), Sparkline(wrong))
+
+	r.printf("\n  %-10s %7s %9s %10s\n", i18n.T("trends.column.week"), i18n.T("trends.column.games"),
+		i18n.T("trends.column.win_rate"), i18n.T("trends.column.avg_wrong"))
+	for _, week := range weekly {
+		r.printf("  %-10s %7d %8.1f%% %10.1f\n", week.Start.Format(dayFormat), week.Games,
+			week.WinRate(), week.AverageWrongGuesses())
+	}
+
+	daily := DailyTrends(history, trendDays, now)
+	perDay := make([]float64, len(daily))
+	for i, day := range daily {
+		perDay[i] = float64(day.Games)
+	}
+	r.println("\n" + i18n.N("trends.days", trendDays))
+	r.printf("  %s\n", Sparkline(perDay))
+
+	r.println("\n" + i18n.T("trends.distribution"))
+	distribution := WrongGuessDistribution(history)
+	labels := make([]string, len(distribution))
+	for i := range distribution {
+		labels[i] = fmt.Sprint(i)
+	}
+	for _, line := range BarChart(labels, distribution, r.barWidth()) {
+		r.println("  " + line)
+	}
+	r.println()
+}
+
+// trendDays is the number of days in the daily activity sparkline
+const trendDays = 14
+
+// barWidth returns the width of the longest bar in the trend charts, narrower
+// than barChartWidth when the screen is
+func (r *Renderer) barWidth() int {
+	// Room for the indent, label and count around the bar
+	if width := r.width - 16; width < barChartWidth {
+		if width < 1 {
+			return 1
+		}
+		return width
+	}
+	return barChartWidth
+}
+
+// barChartWidth is the width of the longest bar in the trend charts
+const barChartWidth = 30
@@ -3,17 +3,48 @@ package game
 import (
 	"bufio"
 	"fmt"
+	"io"
+	"io/fs"
 	"math/rand"
 	"os"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 const fallbackWord = "GOLANG"
 
+// Difficulty names and the word length ranges they select
+const (
+	DifficultyEasy   = "easy"
+	DifficultyMedium = "medium"
+	DifficultyHard   = "hard"
+
+	// DifficultyUnrated is reported for words outside every difficulty range
+	DifficultyUnrated = "unrated"
+)
+
+type lengthRange struct {
+	min, max int
+}
+
+var difficultyRanges = map[string]lengthRange{
+	DifficultyEasy:   {4, 5},
+	DifficultyMedium: {6, 8},
+	DifficultyHard:   {9, 15},
+}
+
 // WordList represents a collection of words for the game
 type WordList struct {
-	Words []string
+	Words    []string      // Playable words; change them with AddWord and RemoveWord to keep the index current
+	Sources  []*WordSource // Sources the words were merged from, if any
+	Language *Language     // Language the words are played in, nil if unknown
+
+	sourceOf   map[string]string // Word -> source tag
+	blocklist  *Blocklist        // Words that are kept out of the list
+	filtered   []string          // Words removed by the blocklist on the last rebuild
+	unplayable []string          // Words removed for letters outside the alphabet on the last rebuild
+	index      *wordIndex        // Lookups by length, source and letters, built on first use
 }
 
 // LoadWordsFromFile loads words from a text file
@@ -28,13 +59,38 @@ func LoadWordsFromFile(filename string) (*WordList, error) {
 		_ = file.Close() //nolint:errcheck // Ignore error on close in defer
 	}()
 
+	return LoadWordsFromReader(file)
+}
+
+// LoadWordsFromFS loads words from a file in a file system such as an embedded pack
+func LoadWordsFromFS(fsys fs.FS, name string) (*WordList, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open word pack: %w", err)
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Ignore error on close in defer
+	}()
+
+	return LoadWordsFromReader(file)
+}
+
+// LoadWordsFromReader loads words from a reader, one word per line. Lines
+// starting with # are comments; "# language: de" and "# alphabet: ..." header
+// lines declare the pack's language, and its words are normalized for it.
+func LoadWordsFromReader(r io.Reader) (*WordList, error) {
 	var words []string
-	scanner := bufio.NewScanner(file)
+	var header []string
+	scanner := bufio.NewScanner(r)
 
 	for scanner.Scan() {
 		word := strings.TrimSpace(scanner.Text())
-		if word != "" && len(word) >= 3 { // Only include words with 3+ letters
-			words = append(words, strings.ToUpper(word))
+		if strings.HasPrefix(word, "#") {
+			header = append(header, word)
+			continue
+		}
+		if word != "" && utf8.RuneCountInString(word) >= 3 { // Only include words with 3+ letters
+			words = append(words, word)
 		}
 	}
 
@@ -46,7 +102,16 @@ func LoadWordsFromFile(filename string) (*WordList, error) {
 		return nil, fmt.Errorf("no valid words found in file")
 	}
 
-	return &WordList{Words: words}, nil
+	lang := ParseLanguageHeader(header)
+	for i, word := range words {
+		if lang != nil {
+			words[i] = lang.Normalize(word)
+		} else {
+			words[i] = strings.ToUpper(word)
+		}
+	}
+
+	return &WordList{Words: words, Language: lang}, nil
 }
 
 // GetDefaultWords returns a default set of words if no file is available
@@ -81,50 +146,186 @@ func (wl *WordList) GetRandomWord() string {
 	return wl.Words[rand.Intn(len(wl.Words))]
 }
 
-// GetWordsByLength returns words of a specific length range
+// GetWordsByLength returns words of a specific length range, shortest first
 func (wl *WordList) GetWordsByLength(minLen, maxLen int) []string {
-	var filtered []string
+	idx := wl.currentIndex()
+	if maxLen > idx.maxLength {
+		maxLen = idx.maxLength
+	}
 
-	for _, word := range wl.Words {
-		if len(word) >= minLen && len(word) <= maxLen {
-			filtered = append(filtered, word)
+	count := 0
+	for length := minLen; length <= maxLen; length++ {
+		if group := idx.byLength[length]; group != nil {
+			count += len(group.members)
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+
+	filtered := make([]string, 0, count)
+	for length := minLen; length <= maxLen; length++ {
+		if group := idx.byLength[length]; group != nil {
+			for _, pos := range group.members {
+				filtered = append(filtered, idx.words[pos])
+			}
 		}
 	}
 
 	return filtered
 }
 
+// GetWordsByCategory returns the words tagged with a source
+func (wl *WordList) GetWordsByCategory(category string) []string {
+	idx := wl.currentIndex()
+	return idx.wordsOf(idx.byCategory[category])
+}
+
+// GetWordsWithLetters returns the words of the given length that contain every
+// letter of include and none of exclude. A length of 0 matches any length.
+func (wl *WordList) GetWordsWithLetters(length int, include, exclude string) []string {
+	idx := wl.currentIndex()
+	var group *wordGroup
+	if length > 0 {
+		if group = idx.byLength[length]; group == nil {
+			return nil
+		}
+	}
+	return idx.withLetters(group, []rune(strings.ToUpper(include)), []rune(strings.ToUpper(exclude)))
+}
+
 // GetWordsByDifficulty returns words based on difficulty level
 func (wl *WordList) GetWordsByDifficulty(difficulty string) []string {
-	switch strings.ToLower(difficulty) {
-	case "easy":
-		return wl.GetWordsByLength(4, 5)
-	case "medium":
-		return wl.GetWordsByLength(6, 8)
-	case "hard":
-		return wl.GetWordsByLength(9, 15)
-	default:
+	r, ok := difficultyRanges[strings.ToLower(difficulty)]
+	if !ok {
 		return wl.Words
 	}
+	return wl.GetWordsByLength(r.min, r.max)
+}
+
+// DifficultyForWord returns the difficulty level a word is selected for
+func DifficultyForWord(word string) string {
+	length := utf8.RuneCountInString(word)
+	for difficulty, r := range difficultyRanges {
+		if length >= r.min && length <= r.max {
+			return difficulty
+		}
+	}
+	return DifficultyUnrated
 }
 
 // AddWord adds a new word to the word list
 func (wl *WordList) AddWord(word string) {
 	word = strings.ToUpper(strings.TrimSpace(word))
-	if word != "" && len(word) >= 3 {
-		wl.Words = append(wl.Words, word)
+	if wl.Language != nil {
+		word = wl.Language.Normalize(word)
+		if !wl.Language.IsPlayable(word) {
+			return
+		}
+	}
+	if word == "" || utf8.RuneCountInString(word) < 3 || wl.blocklist.Contains(word) {
+		return
+	}
+
+	if len(wl.Sources) == 0 {
+		wl.currentIndex().add(word, "")
+		wl.Words = wl.index.words
+		return
+	}
+
+	// Words added to a merged list live in their own source
+	custom := wl.GetSource(CustomSource)
+	if custom == nil {
+		custom = &WordSource{Name: CustomSource, Enabled: true}
+		wl.Sources = append(wl.Sources, custom)
+	}
+	custom.Words = append(custom.Words, word)
+
+	// Like a rebuild, a word already in another source keeps its tag
+	if _, seen := wl.sourceOf[word]; !seen && custom.Enabled {
+		wl.sourceOf[word] = CustomSource
+		wl.currentIndex().add(word, CustomSource)
+		wl.Words = wl.index.words
+	}
+}
+
+// SetBlocklist removes blocked words from the list and keeps them out of later
+// additions. It returns the words that were filtered out. For lists merged from
+// sources, passing nil brings the filtered words back.
+func (wl *WordList) SetBlocklist(b *Blocklist) []string {
+	wl.blocklist = b
+
+	if len(wl.Sources) > 0 {
+		wl.rebuild()
+		return wl.filtered
+	}
+
+	wl.Words, wl.filtered = b.Filter(wl.Words)
+	wl.index = nil
+	return wl.filtered
+}
+
+// SetLanguage normalizes the words for a language and removes words with
+// letters outside its alphabet. Sources that declare a different language are
+// left out. It returns the words that were removed.
+func (wl *WordList) SetLanguage(lang *Language) []string {
+	wl.Language = lang
+
+	if len(wl.Sources) > 0 {
+		wl.rebuild()
+		return wl.unplayable
 	}
+
+	wl.unplayable = nil
+	playable := make([]string, 0, len(wl.Words))
+	for _, word := range wl.Words {
+		word = lang.Normalize(word)
+		if lang.IsPlayable(word) {
+			playable = append(playable, word)
+		} else {
+			wl.unplayable = append(wl.unplayable, word)
+		}
+	}
+	wl.Words = playable
+	wl.index = nil
+	return wl.unplayable
 }
 
-// RemoveWord removes a word from the word list
+// IsBlocked reports whether a word is kept out of the list by the blocklist
+func (wl *WordList) IsBlocked(word string) bool {
+	return wl.blocklist.Contains(word)
+}
+
+// RemoveWord removes every occurrence of a word from the word list, keeping
+// the other words in order
 func (wl *WordList) RemoveWord(word string) {
 	word = strings.ToUpper(strings.TrimSpace(word))
-	for i, w := range wl.Words {
-		if w == word {
-			wl.Words = append(wl.Words[:i], wl.Words[i+1:]...)
-			break
+	if wl.Language != nil {
+		word = wl.Language.Normalize(word)
+	}
+
+	// A copy, as the index shares the old array; it is rebuilt on next use
+	wl.Words = removeAll(append([]string(nil), wl.Words...), word)
+	wl.index = nil
+
+	if len(wl.Sources) > 0 {
+		// Drop it from the sources too, so it doesn't come back on a rebuild
+		for _, source := range wl.Sources {
+			source.Words = removeAll(source.Words, word)
+		}
+		delete(wl.sourceOf, word)
+	}
+}
+
+// removeAll returns words without any occurrence of word, reusing the backing array
+func removeAll(words []string, word string) []string {
+	kept := words[:0]
+	for _, w := range words {
+		if w != word {
+			kept = append(kept, w)
 		}
 	}
+	return kept
 }
 
 // GetWordCount returns the total number of words
@@ -0,0 +1,209 @@
+package game
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/VinayBhutange/hangman-go/utils"
+)
+
+// WordFile holds the raw contents of a word file for maintenance commands
+type WordFile struct {
+	Header   []string  // Comment lines, kept when the file is rewritten
+	Entries  []string  // Non-empty, trimmed lines that are not comments
+	Language *Language // Language declared in the header, nil if none
+}
+
+// WordReport summarizes the contents of a word file
+type WordReport struct {
+	Total            int            // Non-empty entries in the file
+	Unique           int            // Distinct valid words after normalization
+	Duplicates       map[string]int // Normalized word -> number of occurrences (only when > 1)
+	Invalid          []string       // Entries that are too short or use letters outside the alphabet
+	MixedCase        []string       // Entries that mix upper and lower case letters
+	Blocked          []string       // Valid words rejected by the blocklist
+	LengthHistogram  map[int]int    // Word length -> number of unique valid words
+	DifficultyCounts map[string]int // Difficulty -> number of unique valid words
+}
+
+// HasIssues reports whether the file has entries that need attention
+func (r *WordReport) HasIssues() bool {
+	return len(r.Duplicates) > 0 || len(r.Invalid) > 0 || len(r.MixedCase) > 0 || len(r.Blocked) > 0
+}
+
+// ReadWordFile reads a word file without filtering its entries
+func ReadWordFile(filename string) (*WordFile, error) {
+	//nolint:gosec // G304: File path is controlled by the application
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open word file: %w", err)
+	}
+	defer func() {
+		_ = file.Close() //nolint:errcheck // Ignore error on close in defer
+	}()
+
+	wf := &WordFile{}
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		entry := strings.TrimSpace(scanner.Text())
+		switch {
+		case entry == "":
+			continue
+		case strings.HasPrefix(entry, "#"):
+			wf.Header = append(wf.Header, entry)
+		default:
+			wf.Entries = append(wf.Entries, entry)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading word file: %w", err)
+	}
+
+	wf.Language = ParseLanguageHeader(wf.Header)
+	return wf, nil
+}
+
+// WriteWordFile writes header lines followed by words, one lowercase word per line
+func WriteWordFile(filename string, header, words []string) error {
+	var sb strings.Builder
+	for _, line := range header {
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+	for _, word := range words {
+		sb.WriteString(strings.ToLower(word))
+		sb.WriteString("\n")
+	}
+
+	if err := os.WriteFile(filename, []byte(sb.String()), 0o600); err != nil {
+		return fmt.Errorf("failed to write word file: %w", err)
+	}
+
+	return nil
+}
+
+// Analyze inspects the entries for duplicates, invalid entries, mixed case and
+// words on the blocklist. The blocklist may be nil.
+func (wf *WordFile) Analyze(blocklist *Blocklist) *WordReport {
+	report := &WordReport{
+		Total:            len(wf.Entries),
+		Duplicates:       make(map[string]int),
+		LengthHistogram:  make(map[int]int),
+		DifficultyCounts: make(map[string]int),
+	}
+
+	counts := make(map[string]int)
+	for _, entry := range wf.Entries {
+		word, ok := wf.normalize(entry)
+		if !ok {
+			report.Invalid = append(report.Invalid, entry)
+			continue
+		}
+
+		if isMixedCase(entry) {
+			report.MixedCase = append(report.MixedCase, entry)
+		}
+
+		counts[word]++
+		if counts[word] > 1 {
+			continue
+		}
+
+		if blocklist.Contains(word) {
+			report.Blocked = append(report.Blocked, word)
+		}
+
+		report.LengthHistogram[utf8.RuneCountInString(word)]++
+		report.DifficultyCounts[DifficultyForWord(word)]++
+	}
+
+	for word, count := range counts {
+		if count > 1 {
+			report.Duplicates[word] = count
+		}
+	}
+	report.Unique = len(counts)
+
+	return report
+}
+
+// Normalized returns the entries uppercased, without invalid entries or
+// duplicates, keeping first occurrences in order. Letters the language folds
+// for play, such as accents, are kept; they only decide what is a duplicate.
+func (wf *WordFile) Normalized() []string {
+	seen := make(map[string]bool)
+	words := make([]string, 0, len(wf.Entries))
+
+	for _, entry := range wf.Entries {
+		key, ok := wf.normalize(entry)
+		if !ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+		words = append(words, strings.ToUpper(entry))
+	}
+
+	return words
+}
+
+// Sorted returns the normalized words in alphabetical order
+func (wf *WordFile) Sorted() []string {
+	words := wf.Normalized()
+	if wf.Language == nil {
+		sort.Strings(words)
+		return words
+	}
+
+	// Order by the language's alphabet so letters like Ñ sort where players
+	// expect, and accented letters next to the ones they fold to
+	order := make(map[rune]int, len(wf.Language.Alphabet))
+	for i, r := range wf.Language.Alphabet {
+		order[r] = i
+	}
+	keys := make(map[string][]rune, len(words))
+	for _, word := range words {
+		key, _ := wf.normalize(word)
+		keys[word] = []rune(key)
+	}
+	sort.Slice(words, func(i, j int) bool {
+		a, b := keys[words[i]], keys[words[j]]
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return order[a[k]] < order[b[k]]
+			}
+		}
+		return len(a) < len(b)
+	})
+	return words
+}
+
+// normalize returns the playable form of an entry, which identifies
+// duplicates, and whether it is valid
+func (wf *WordFile) normalize(entry string) (string, bool) {
+	if wf.Language == nil {
+		return strings.ToUpper(entry), utils.IsValidWord(entry)
+	}
+
+	word := wf.Language.Normalize(entry)
+	return word, utf8.RuneCountInString(word) >= 3 && wf.Language.IsPlayable(word)
+}
+
+// isMixedCase checks whether a word contains both upper and lower case letters
+func isMixedCase(word string) bool {
+	hasUpper, hasLower := false, false
+	for _, r := range word {
+		if unicode.IsUpper(r) {
+			hasUpper = true
+		} else if unicode.IsLower(r) {
+			hasLower = true
+		}
+	}
+	return hasUpper && hasLower
+}
@@ -0,0 +1,256 @@
+// Package i18n provides the translated user interface messages of the game.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultLocale is used when no other locale is selected, and for keys missing from a translation
+const DefaultLocale = "en"
+
+//go:embed locales/*.json
+var localeFS embed.FS
+
+// Message is a translated message with an optional plural form. In the locale
+// files it is either a plain string or an object with "one" and "other" forms.
+type Message struct {
+	One   string
+	Other string
+}
+
+// UnmarshalJSON accepts either a string or a {"one": ..., "other": ...} object
+func (m *Message) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		m.One, m.Other = text, text
+		return nil
+	}
+
+	var forms struct {
+		One   string `json:"one"`
+		Other string `json:"other"`
+	}
+	if err := json.Unmarshal(data, &forms); err != nil {
+		return fmt.Errorf("message must be a string or plural object: %w", err)
+	}
+	if forms.Other == "" {
+		return fmt.Errorf("plural message is missing the \"other\" form")
+	}
+	if forms.One == "" {
+		forms.One = forms.Other
+	}
+	m.One, m.Other = forms.One, forms.Other
+	return nil
+}
+
+// Catalog holds the messages of one locale
+type Catalog struct {
+	Locale   string
+	Messages map[string]Message
+}
+
+var (
+	fallback = mustLoad(DefaultLocale)
+	active   = fallback
+	plain    bool // Leave emoji out of messages
+)
+
+// LoadCatalog loads the embedded catalog for a locale
+func LoadCatalog(locale string) (*Catalog, error) {
+	data, err := localeFS.ReadFile(path.Join("locales", locale+".json"))
+	if err != nil {
+		return nil, fmt.Errorf("unknown locale %q", locale)
+	}
+
+	catalog := &Catalog{Locale: locale}
+	if err := json.Unmarshal(data, &catalog.Messages); err != nil {
+		return nil, fmt.Errorf("failed to parse locale %q: %w", locale, err)
+	}
+	return catalog, nil
+}
+
+// mustLoad loads a catalog that is known to be embedded
+func mustLoad(locale string) *Catalog {
+	catalog, err := LoadCatalog(locale)
+	if err != nil {
+		panic(err)
+	}
+	return catalog
+}
+
+// Locales returns the codes of every embedded locale
+func Locales() []string {
+	matches, _ := fs.Glob(localeFS, "locales/*.json") //nolint:errcheck // The pattern is valid
+	locales := make([]string, 0, len(matches))
+	for _, match := range matches {
+		locales = append(locales, strings.TrimSuffix(path.Base(match), ".json"))
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// Keys returns the message keys of a catalog in sorted order
+func (c *Catalog) Keys() []string {
+	keys := make([]string, 0, len(c.Messages))
+	for key := range c.Messages {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// SetLocale makes a locale the active one
+func SetLocale(locale string) error {
+	catalog, err := LoadCatalog(locale)
+	if err != nil {
+		return err
+	}
+	active = catalog
+	return nil
+}
+
+// Locale returns the active locale
+func Locale() string {
+	return active.Locale
+}
+
+// DetectLocale picks the first supported locale from the given preferences
+// (such as a command line flag and a config value), then from the LC_ALL,
+// LC_MESSAGES and LANG environment variables, falling back to DefaultLocale
+func DetectLocale(preferences ...string) string {
+	candidates := make([]string, 0, len(preferences)+3)
+	candidates = append(candidates, preferences...)
+	candidates = append(candidates, os.Getenv("LC_ALL"), os.Getenv("LC_MESSAGES"), os.Getenv("LANG"))
+
+	for _, candidate := range candidates {
+		if locale, ok := MatchLocale(candidate); ok {
+			return locale
+		}
+	}
+	return DefaultLocale
+}
+
+// MatchLocale returns the embedded locale for a value such as "de_DE.UTF-8"
+func MatchLocale(value string) (string, bool) {
+	locale := normalizeLocale(value)
+	for _, code := range Locales() {
+		if code == locale {
+			return code, true
+		}
+	}
+	return "", false
+}
+
+// normalizeLocale turns values like "de_DE.UTF-8" into "de"
+func normalizeLocale(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if i := strings.IndexAny(value, "_.-@"); i >= 0 {
+		value = value[:i]
+	}
+	return value
+}
+
+// T returns the translated message for a key formatted with args. Keys missing
+// from the active locale fall back to English, and unknown keys are returned as is.
+func T(key string, args ...interface{}) string {
+	return format(lookup(key).Other, key, args)
+}
+
+// N returns the plural form of a message that matches n. When no args are
+// given, n itself is used to format the message.
+func N(key string, n int, args ...interface{}) string {
+	if len(args) == 0 {
+		args = []interface{}{n}
+	}
+
+	msg := lookup(key)
+	if pluralOne(active.Locale, n) {
+		return format(msg.One, key, args)
+	}
+	return format(msg.Other, key, args)
+}
+
+// lookup finds a message in the active catalog or the fallback
+func lookup(key string) Message {
+	if msg, ok := active.Messages[key]; ok {
+		return msg
+	}
+	if msg, ok := fallback.Messages[key]; ok {
+		return msg
+	}
+	return Message{One: key, Other: key}
+}
+
+// format applies args to a message template
+func format(template, key string, args []interface{}) string {
+	text := template
+	if template != key && len(args) > 0 {
+		text = fmt.Sprintf(template, args...)
+	}
+	if plain {
+		return StripSymbols(text)
+	}
+	return text
+}
+
+// UsePlainText makes T and N leave emoji out of messages, for screen readers,
+// which read them out by name or skip them
+func UsePlainText(on bool) {
+	plain = on
+}
+
+// PlainText reports whether emoji are left out of messages
+func PlainText() bool {
+	return plain
+}
+
+// StripSymbols removes emoji and other pictographs from text, along with the
+// spaces that follow them
+func StripSymbols(text string) string {
+	var sb strings.Builder
+	dropped := false
+	for _, r := range text {
+		if isSymbol(r) {
+			dropped = true
+			continue
+		}
+		if dropped && r == ' ' {
+			continue
+		}
+		dropped = false
+		sb.WriteRune(r)
+	}
+	if dropped {
+		// Text ending in an emoji would keep the space before it
+		return strings.TrimRight(sb.String(), " ")
+	}
+	return sb.String()
+}
+
+// isSymbol reports whether r is part of an emoji: a pictograph, a skin tone,
+// a variation selector or a joiner
+func isSymbol(r rune) bool {
+	switch {
+	case r < utf8.RuneSelf:
+		return false
+	case r == '\u2139', r == '\ufe0e', r == '\ufe0f', r == '\u200d': // ℹ is a letter to Unicode
+		return true
+	default:
+		return unicode.In(r, unicode.So, unicode.Sk)
+	}
+}
+
+// pluralOne reports whether n takes the singular form. English, German and
+// Spanish all use "one" for exactly 1.
+func pluralOne(_ string, n int) bool {
+	return n == 1
+}
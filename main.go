@@ -1,41 +1,109 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/VinayBhutange/hangman-go/assets"
+	"github.com/VinayBhutange/hangman-go/data"
 	"github.com/VinayBhutange/hangman-go/game"
+	"github.com/VinayBhutange/hangman-go/i18n"
+	"github.com/VinayBhutange/hangman-go/terminal"
 	"github.com/VinayBhutange/hangman-go/utils"
 )
 
+// Command line flags
+var (
+	wordsFlag    = flag.String("words", "", "path to a word file to play with")
+	wordsDirFlag = flag.String("words-dir", "", "directory of extra word files to merge in (default <data dir>/words)")
+	langFlag     = flag.String("lang", "", "language to play in: "+strings.Join(data.PackLanguages(), ", "))
+	localeFlag   = flag.String("locale", "", "interface language: "+strings.Join(i18n.Locales(), ", ")+" (default from LANG)")
+	profileFlag  = flag.String("profile", "", "profile to play as, created if it doesn't exist (default: ask when there are several)")
+	colorFlag    = flag.String("color", string(utils.ColorAuto), "when to use colors: auto, always or never (auto follows NO_COLOR, FORCE_COLOR and whether output is a terminal)")
+	accessFlag   = flag.Bool("accessible", false, "screen reader mode: describe the board in words, without art, emoji or screen clears (also a setting)")
+	asciiFlag    = flag.Bool("ascii", false, "draw charts with ASCII characters (default when the locale isn't UTF-8)")
+	tuiFlag      = flag.Bool("tui", true, "play full-screen with single-key guesses when running in a terminal")
+	dataDirFlag  = flag.String("data-dir", "", "directory for statistics, history, settings and custom words (default $HANGMAN_HOME, $XDG_DATA_HOME/hangman or ~/.hangman)")
+)
+
 func main() {
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		fmt.Fprintln(flag.CommandLine.Output(), "\nOptions:")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	colorMode, err := utils.ParseColorMode(*colorFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	utils.SetColorMode(colorMode)
+
+	// Every file the game reads or writes is resolved against the data directory
+	game.SetDataDir(*dataDirFlag)
+
+	// Run a maintenance command instead of the game when one is given
+	if flag.NArg() > 0 {
+		if *profileFlag != "" {
+			if err := game.SetProfile(*profileFlag); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(2)
+			}
+		}
+		os.Exit(runCommand(flag.Args()))
+	}
+
+	// Load settings
+	config, err := game.LoadConfig()
+	if err != nil {
+		log.Printf("Warning: Could not load settings: %v", err)
+		config = game.NewConfig()
+	}
+
+	// Pick the interface language before anything is shown
+	if _, ok := i18n.MatchLocale(*localeFlag); *localeFlag != "" && !ok {
+		log.Printf("Warning: Unknown locale %q", *localeFlag)
+	}
+	_ = i18n.SetLocale(i18n.DetectLocale(*localeFlag, config.Locale)) //nolint:errcheck // Detected locales are always embedded
+
+	applyAppearance(config)
+
 	// Display title
-	fmt.Print(assets.GameTitle())
-	fmt.Println(utils.Bold("\nWelcome to Hangman!"))
+	printArt(assets.GameTitle())
+	fmt.Println(utils.Bold("\n" + i18n.T("main.welcome")))
 	fmt.Println("===================")
 
+	// Choose who is playing
+	chooseProfile(config)
+
 	// Load statistics
-	stats, err := game.LoadStatistics()
+	stats, err := openStatistics(config)
 	if err != nil {
-		log.Printf("Warning: Could not load statistics: %v", err)
-		stats = game.NewStatistics()
+		// Playing on would overwrite statistics this build doesn't understand
+		fmt.Println(utils.Error(i18n.T("stats.newer_version", err)))
+		os.Exit(1)
 	}
+	achievements := openAchievements()
 
-	// Load words
-	wordList, err := loadWords()
-	if err != nil {
-		log.Printf("Warning: Could not load words from file: %v", err)
-		log.Println("Using default word list instead.")
-		wordList = game.GetDefaultWords()
-	}
+	// Choose the language to play in
+	lang := chooseLanguage(config)
+	wordList := loadWordList(lang, config)
 
 	// Show welcome message with statistics
 	if stats.GamesPlayed > 0 {
-		fmt.Printf("Welcome back! You've played %d games with a %.1f%% win rate.\n\n",
-			stats.GamesPlayed, stats.GetWinRate())
+		fmt.Println(i18n.N("main.welcome_back", stats.GamesPlayed, stats.GamesPlayed, stats.GetWinRate()))
+		fmt.Println()
 	}
 
 	// Main menu loop
@@ -45,40 +113,119 @@ func main() {
 		switch choice {
 		case "1":
 			// Play game
-			playHangmanGame(wordList, stats)
+			playHangmanGame(wordList, stats, achievements)
 		case "2":
 			// View statistics
-			stats.PrintStatistics()
-			utils.WaitForEnter()
+			showStatisticsMenu(stats, achievements)
 		case "3":
-			// Settings/Options
-			showSettingsMenu(wordList, stats)
+			// Switch, create and compare profiles
+			showProfilesMenu(wordList, stats, achievements, config, lang)
 		case "4":
+			// Settings/Options
+			showSettingsMenu(wordList, stats, config)
+		case "5":
 			// Exit
-			fmt.Println(utils.Info("Thanks for playing Hangman! 👋"))
+			fmt.Println(utils.Info(i18n.T("main.goodbye")))
 			printFinalStats(stats)
 			return
 		default:
-			fmt.Println(utils.Error("Invalid choice. Please try again."))
+			fmt.Println(utils.Error(i18n.T("menu.invalid_choice")))
 		}
 
 		game.ClearScreen()
 	}
 }
 
+// openStatistics prunes and loads the active profile's history and statistics.
+// Load problems are reported and fresh statistics are used, except for files
+// from a newer version, which are returned as an error.
+func openStatistics(config *game.Config) (*game.Statistics, error) {
+	// Drop games that are past the history retention
+	if _, err := game.PruneHistory(config.HistoryMaxGames, config.HistoryMaxDays); err != nil {
+		log.Printf("Warning: Could not prune history: %v", err)
+	}
+
+	stats, err := game.LoadStatistics()
+	if errors.Is(err, game.ErrNewerVersion) {
+		return nil, err
+	}
+	if err != nil {
+		log.Printf("Warning: Could not load statistics: %v", err)
+		stats = game.NewStatistics()
+	}
+	if stats.RecoveredFrom != "" {
+		fmt.Println(utils.Warning(i18n.T("stats.recovered", stats.RecoveredFrom)))
+	}
+	return stats, nil
+}
+
+// openAchievements loads the active profile's unlocked achievements, starting
+// over if they can't be read
+func openAchievements() *game.AchievementState {
+	achievements, err := game.LoadAchievements()
+	if err != nil {
+		log.Printf("Warning: %s", i18n.T("achievements.load_error", err))
+		achievements = game.NewAchievementState(game.Achievements())
+	}
+	return achievements
+}
+
+// loadWordList loads the words for a language, including the active profile's
+// custom words, with the saved source and content settings applied
+func loadWordList(lang *game.Language, config *game.Config) *game.WordList {
+	// Load words
+	baseSource, description, err := loadWords(lang)
+	if err != nil {
+		log.Printf("Warning: Could not load words: %v", err)
+		log.Println("Using default word list instead.")
+		baseSource = game.NewWordSource("default", "", game.GetDefaultWords())
+		description = i18n.T("words.default_list")
+	}
+	fmt.Println(utils.Info(i18n.N("words.loaded", len(baseSource.Words), len(baseSource.Words), description)))
+
+	// A word file's declared language takes precedence over the choice
+	if declared, ok := game.GetLanguage(baseSource.Language); ok && declared != lang {
+		fmt.Println(utils.Info(i18n.T("language.declared", declared.Name, description)))
+		lang = declared
+	}
+	game.SetActiveLanguage(lang)
+
+	// Merge in any extra word files
+	wordList := game.MergeWordSources(append([]*game.WordSource{baseSource}, loadWordDirectory()...))
+	for _, source := range wordList.Sources {
+		if config.IsSourceDisabled(source.Name) && enabledSourceCount(wordList) > 1 {
+			_ = wordList.SetSourceEnabled(source.Name, false) //nolint:errcheck // Source name comes from the list
+		}
+	}
+	if unplayable := wordList.SetLanguage(lang); len(unplayable) > 0 {
+		fmt.Println(utils.Warning(i18n.N("words.unplayable", len(unplayable), len(unplayable), lang.Name)))
+	}
+	applyContentFilter(wordList, config)
+
+	// Leave out the words removed from the settings menu
+	removed, err := game.LoadRemovedWords()
+	if err != nil {
+		log.Printf("Warning: Could not load removed words: %v", err)
+	}
+	wordList.RemoveWords(removed)
+
+	return wordList
+}
+
 // showMainMenu displays the main menu and returns user choice
 func showMainMenu() string {
-	fmt.Println(utils.Bold("🎮 MAIN MENU"))
+	fmt.Println(utils.Bold(i18n.T("menu.main.title")))
 	fmt.Println("=============")
-	fmt.Println("1. 🎯 Play Hangman")
-	fmt.Println("2. 📊 View Statistics")
-	fmt.Println("3. ⚙️  Settings")
-	fmt.Println("4. 🚪 Exit")
+	fmt.Println("1. " + i18n.T("menu.main.play"))
+	fmt.Println("2. " + i18n.T("menu.main.statistics"))
+	fmt.Println("3. " + i18n.T("menu.main.profiles", game.ActiveProfile()))
+	fmt.Println("4. " + i18n.T("menu.main.settings"))
+	fmt.Println("5. " + i18n.T("menu.main.exit"))
 	fmt.Println()
 
-	choice, err := utils.GetUserInput("Enter your choice (1-4): ")
+	choice, err := utils.GetUserInput(i18n.T("menu.choice", 1, 5))
 	if err != nil {
-		fmt.Println(utils.Error("Error reading input: " + err.Error()))
+		fmt.Println(utils.Error(i18n.T("input.read_error", err)))
 		return ""
 	}
 
@@ -86,20 +233,26 @@ func showMainMenu() string {
 }
 
 // showSettingsMenu displays the settings menu
-func showSettingsMenu(wordList *game.WordList, stats *game.Statistics) {
+func showSettingsMenu(wordList *game.WordList, stats *game.Statistics, config *game.Config) {
 	for {
-		fmt.Println(utils.Bold("⚙️ SETTINGS"))
+		fmt.Println(utils.Bold(i18n.T("menu.settings.title")))
 		fmt.Println("============")
-		fmt.Println("1. 📝 Add Custom Word")
-		fmt.Println("2. 🗑️  Remove Word")
-		fmt.Println("3. 📋 List All Words")
-		fmt.Println("4. 🔄 Reset Statistics")
-		fmt.Println("5. 🔙 Back to Main Menu")
+		fmt.Println("1. " + i18n.T("menu.settings.add_word"))
+		fmt.Println("2. " + i18n.T("menu.settings.remove_word"))
+		fmt.Println("3. " + i18n.T("menu.settings.list_words"))
+		fmt.Println("4. " + i18n.T("menu.settings.word_sources"))
+		fmt.Println("5. " + i18n.T("menu.settings.family_friendly", onOff(config.FamilyFriendly)))
+		fmt.Println("6. " + i18n.T("menu.settings.interface_language", i18n.Locale()))
+		fmt.Println("7. " + i18n.T("menu.settings.history_retention", describeRetention(config)))
+		fmt.Println("8. " + i18n.T("menu.settings.appearance", game.ActiveArtPack().Name, utils.CurrentTheme().Name))
+		fmt.Println("9. " + i18n.T("menu.settings.transfer"))
+		fmt.Println("10. " + i18n.T("menu.settings.reset_stats"))
+		fmt.Println("11. " + i18n.T("menu.settings.back"))
 		fmt.Println()
 
-		choice, err := utils.GetUserInput("Enter your choice (1-5): ")
+		choice, err := utils.GetUserInput(i18n.T("menu.choice", 1, 11))
 		if err != nil {
-			fmt.Println(utils.Error("Error reading input: " + err.Error()))
+			fmt.Println(utils.Error(i18n.T("input.read_error", err)))
 			continue
 		}
 
@@ -111,66 +264,431 @@ func showSettingsMenu(wordList *game.WordList, stats *game.Statistics) {
 		case "3":
 			listWords(wordList)
 		case "4":
-			resetStatistics(stats)
+			toggleWordSources(wordList, config)
 		case "5":
+			toggleFamilyFriendly(wordList, config)
+		case "6":
+			chooseLocale(config)
+		case "7":
+			configureHistoryRetention(config)
+		case "8":
+			showAppearanceMenu(config)
+		case "9":
+			showTransferMenu(stats)
+		case "10":
+			resetStatistics(stats)
+		case "11":
 			return
 		default:
-			fmt.Println(utils.Error("Invalid choice. Please try again."))
+			fmt.Println(utils.Error(i18n.T("menu.invalid_choice")))
+		}
+
+		fmt.Println()
+	}
+}
+
+// showStatisticsMenu shows the statistics with a way into the game history
+func showStatisticsMenu(stats *game.Statistics, achievements *game.AchievementState) {
+	for {
+		stats.PrintStatistics()
+		fmt.Println("1. " + i18n.T("menu.statistics.history"))
+		fmt.Println("2. " + i18n.T("menu.statistics.analytics"))
+		fmt.Println("3. " + i18n.T("menu.statistics.trends"))
+		fmt.Println("4. " + i18n.T("menu.statistics.trophies"))
+		fmt.Println("5. " + i18n.T("menu.settings.back"))
+		fmt.Println()
+
+		choice, err := utils.GetUserInput(i18n.T("menu.choice", 1, 5))
+		if err != nil {
+			return
+		}
+
+		switch strings.TrimSpace(choice) {
+		case "1":
+			browseHistory()
+		case "2":
+			showAnalytics()
+		case "3":
+			showTrends()
+		case "4":
+			showTrophies(achievements)
+		case "5", "":
+			return
+		default:
+			fmt.Println(utils.Error(i18n.T("menu.invalid_choice")))
+		}
+		fmt.Println()
+	}
+}
+
+// showAnalytics shows letter and word length statistics from the game history
+func showAnalytics() {
+	entries, err := game.LoadHistory()
+	if err != nil {
+		fmt.Println(utils.Error(i18n.T("history.load_error", err)))
+		return
+	}
+	game.AnalyzeHistory(entries).PrintAnalytics()
+	utils.WaitForEnter()
+}
+
+// defaultTrendWeeks is the number of weeks the trends screen shows unless asked otherwise
+const defaultTrendWeeks = 8
+
+// showTrends shows weekly and daily charts from the game history
+func showTrends() {
+	weeks, ok := getCountInput(i18n.T("trends.weeks_prompt", defaultTrendWeeks), defaultTrendWeeks)
+	if !ok {
+		return
+	}
+	if weeks == 0 {
+		weeks = defaultTrendWeeks
+	}
+
+	entries, err := game.LoadHistory()
+	if err != nil {
+		fmt.Println(utils.Error(i18n.T("history.load_error", err)))
+		return
+	}
+	game.PrintTrends(entries, weeks, time.Now())
+	utils.WaitForEnter()
+}
+
+// showTrophies lists every achievement, unlocked ones with their unlock date
+func showTrophies(achievements *game.AchievementState) {
+	definitions := achievements.Definitions()
+	fmt.Println(utils.Bold(i18n.T("achievements.title")))
+	fmt.Println("===========")
+	fmt.Println(i18n.T("achievements.progress", len(achievements.Unlocked), len(definitions)))
+	fmt.Println()
+
+	for _, achievement := range definitions {
+		if unlocked, ok := achievements.IsUnlocked(achievement.ID); ok {
+			line := fmt.Sprintf("%s %s (%s)", achievement.Icon, utils.Bold(achievement.Name()),
+				i18n.T("achievements.unlocked_on", unlocked.Format("2006-01-02")))
+			if i18n.PlainText() {
+				line = i18n.StripSymbols(line)
+			}
+			fmt.Println(line)
+		} else {
+			fmt.Println(i18n.T("achievements.locked", achievement.Name()))
 		}
+		fmt.Printf("   %s\n", achievement.Description())
+	}
+
+	fmt.Println()
+	utils.WaitForEnter()
+}
 
+// showUnlocked announces achievements unlocked by the last game
+func showUnlocked(unlocked []game.Achievement) {
+	for _, achievement := range unlocked {
+		banner := i18n.T("achievements.banner", achievement.Icon, achievement.Name())
+		if game.AccessibleMode() {
+			fmt.Println()
+			fmt.Println(banner)
+			fmt.Println(achievement.Description())
+			continue
+		}
+
+		line := utils.Yellow(strings.Repeat("═", utf8.RuneCountInString(banner)+2))
 		fmt.Println()
+		fmt.Println(line)
+		fmt.Println(" " + utils.Bold(banner))
+		fmt.Println("   " + achievement.Description())
+		fmt.Println(line)
 	}
 }
 
+// historyPageSize is the number of games shown per page of the history browser
+const historyPageSize = 10
+
+// browseHistory pages through past games, newest first
+func browseHistory() {
+	entries, err := game.LoadHistory()
+	if err != nil {
+		fmt.Println(utils.Error(i18n.T("history.load_error", err)))
+		return
+	}
+	if len(entries) == 0 {
+		fmt.Println(utils.Info(i18n.T("history.empty")))
+		return
+	}
+
+	lossesOnly := false
+	page := 0
+	for {
+		// Newest first, optionally losses only
+		var shown []game.HistoryEntry
+		for i := len(entries) - 1; i >= 0; i-- {
+			if !lossesOnly || !entries[i].Won() {
+				shown = append(shown, entries[i])
+			}
+		}
+		pages := (len(shown) + historyPageSize - 1) / historyPageSize
+		if pages == 0 {
+			pages = 1
+		}
+		if page >= pages {
+			page = pages - 1
+		}
+
+		fmt.Println()
+		fmt.Println(utils.Bold(i18n.T("history.title")))
+		fmt.Println("===============")
+		if lossesOnly {
+			fmt.Println(utils.Info(i18n.T("history.losses_only")))
+		}
+		start := page * historyPageSize
+		for i := start; i < len(shown) && i < start+historyPageSize; i++ {
+			entry := shown[i]
+			fmt.Printf("%3d. %s  %-15s %-7s %-12s %s %d/%d  %s\n", i+1,
+				entry.PlayedAt.Format("2006-01-02 15:04"), entry.Word, entry.Difficulty, entry.Category,
+				formatResult(entry), entry.WrongGuesses, entry.MaxWrong, entry.Duration().Round(time.Second))
+		}
+		fmt.Println(i18n.T("history.page", page+1, pages))
+		fmt.Println()
+
+		input, err := utils.GetUserInput(i18n.T("history.prompt"))
+		if err != nil || input == "" {
+			return
+		}
+
+		switch strings.ToLower(input) {
+		case "n":
+			if page < pages-1 {
+				page++
+			}
+		case "p":
+			if page > 0 {
+				page--
+			}
+		case "l":
+			lossesOnly, page = true, 0
+		case "a":
+			lossesOnly, page = false, 0
+		default:
+			index, err := strconv.Atoi(input)
+			if err != nil || index < 1 || index > len(shown) {
+				fmt.Println(utils.Error(i18n.T("menu.invalid_choice")))
+				continue
+			}
+			showHistoryEntry(shown[index-1])
+		}
+	}
+}
+
+// showHistoryEntry prints every detail of a past game
+func showHistoryEntry(entry game.HistoryEntry) {
+	// Color each guess as a hit or a miss
+	var guesses []string
+	for _, letter := range entry.Guesses {
+		if strings.ContainsRune(entry.Word, letter) {
+			guesses = append(guesses, utils.Green(string(letter)))
+		} else {
+			guesses = append(guesses, utils.Red(string(letter)))
+		}
+	}
+
+	fmt.Println()
+	fmt.Println(i18n.T("history.detail.word", utils.Bold(entry.Word)))
+	fmt.Println(i18n.T("history.detail.played", entry.PlayedAt.Format("2006-01-02 15:04:05")))
+	fmt.Println(i18n.T("history.detail.difficulty", entry.Difficulty))
+	if entry.Category != "" {
+		fmt.Println(i18n.T("history.detail.category", entry.Category))
+	}
+	fmt.Println(i18n.T("history.detail.result", formatResult(entry)))
+	fmt.Println(i18n.T("history.detail.guesses", strings.Join(guesses, " ")))
+	fmt.Println(i18n.T("history.detail.wrong", entry.WrongGuesses, entry.MaxWrong))
+	fmt.Println(i18n.T("history.detail.duration", entry.Duration().Round(time.Second)))
+	fmt.Println(i18n.T("history.detail.seed", entry.Seed))
+	fmt.Println()
+	utils.WaitForEnter()
+}
+
+// formatResult returns the colored result of a past game
+func formatResult(entry game.HistoryEntry) string {
+	if entry.Won() {
+		return utils.Green(i18n.T("history.won"))
+	}
+	return utils.Red(i18n.T("history.lost"))
+}
+
 // playHangmanGame plays a single game session
-func playHangmanGame(wordList *game.WordList, stats *game.Statistics) {
+func playHangmanGame(wordList *game.WordList, stats *game.Statistics, achievements *game.AchievementState) {
 	// Get difficulty level
 	difficulty := getDifficulty()
 
 	// Get words for selected difficulty
 	words := wordList.GetWordsByDifficulty(difficulty)
 	if len(words) == 0 {
-		fmt.Println(utils.Warning("No words available for selected difficulty. Using all words."))
+		fmt.Println(utils.Warning(i18n.T("game.no_words_for_difficulty")))
 		words = wordList.Words
 	}
 
 	// Start new game
 	hangmanGame := game.NewGame(words)
+	hangmanGame.Source = wordList.SourceOf(hangmanGame.Word)
 
 	// Play the game
 	won := playGame(hangmanGame)
 
-	// Record statistics
-	stats.RecordGame(hangmanGame, difficulty)
+	// Record statistics, update ratings and check for new achievements
+	entry := stats.RecordGame(hangmanGame, difficulty)
+	unlocked := achievements.Evaluate(stats, entry)
+	change, ratingErr := game.RecordRatedGame(entry)
+	if ratingErr != nil {
+		log.Printf("Warning: Could not save ratings: %v", ratingErr)
+	}
 
 	// Save statistics
 	if err := stats.SaveStatistics(); err != nil {
 		log.Printf("Warning: Could not save statistics: %v", err)
 	}
+	// Evaluate also moves the streak of days played on, so save after every game
+	if err := achievements.Save(); err != nil {
+		log.Printf("Warning: Could not save achievements: %v", err)
+	}
 
 	// Show brief stats
 	if won {
-		fmt.Printf(utils.Success("Game won! Current streak: %d\n"), stats.CurrentStreak)
+		fmt.Println(utils.Success(i18n.T("game.won_streak", stats.CurrentStreak)))
 	} else {
-		fmt.Printf(utils.Error("Game lost. Win rate: %.1f%%\n"), stats.GetWinRate())
+		fmt.Println(utils.Error(i18n.T("game.lost_win_rate", stats.GetWinRate())))
+	}
+	if ratingErr == nil {
+		showRatingChange(change)
 	}
 
+	showUnlocked(unlocked)
+
 	utils.WaitForEnter()
 }
 
-// loadWords attempts to load words from file, returns error if unsuccessful
-func loadWords() (*game.WordList, error) {
-	// Try to load from data/words.txt first
-	if _, err := os.Stat("data/words.txt"); err == nil {
-		return game.LoadWordsFromFile("data/words.txt")
+// loadWords loads words from the --words flag, the user's data directory or the
+// embedded pack for the language, in that order, and returns a description of
+// the source used
+func loadWords(lang *game.Language) (*game.WordSource, string, error) {
+	// An explicit file on the command line must load or it's an error
+	if *wordsFlag != "" {
+		return loadWordFile(*wordsFlag)
 	}
 
-	// If that fails, try relative path
-	if _, err := os.Stat("./data/words.txt"); err == nil {
-		return game.LoadWordsFromFile("./data/words.txt")
+	// Next, a words.txt the user placed in their profile directory
+	if profileDir, err := game.ProfileDir(); err == nil {
+		userFile := filepath.Join(profileDir, data.DefaultPack)
+		if _, err := os.Stat(userFile); err == nil {
+			return loadWordFile(userFile)
+		}
 	}
 
-	return nil, fmt.Errorf("word file not found")
+	// Finally, the pack embedded in the binary
+	pack := data.PackName(lang.Code)
+	wordList, err := game.LoadWordsFromFS(data.Packs, pack)
+	if err != nil {
+		return nil, "", err
+	}
+	return game.NewWordSource(game.SourceName(pack), "", wordList), i18n.T("words.embedded_pack", pack), nil
+}
+
+// chooseLanguage returns the language from --lang, or asks the player to pick
+// one of the languages with a word pack, defaulting to the last choice
+func chooseLanguage(config *game.Config) *game.Language {
+	if *langFlag != "" {
+		if lang, ok := game.GetLanguage(*langFlag); ok {
+			return lang
+		}
+		log.Printf("Warning: Unknown language %q", *langFlag)
+	}
+
+	current, ok := game.GetLanguage(config.Language)
+	if !ok {
+		current, _ = game.GetLanguage(game.DefaultLanguage)
+	}
+
+	// An explicit word file declares its own language
+	codes := data.PackLanguages()
+	if *wordsFlag != "" || len(codes) < 2 {
+		return current
+	}
+
+	fmt.Println(utils.Bold(i18n.T("language.title")))
+	fmt.Println("===========")
+	var choices []*game.Language
+	for _, code := range codes {
+		if lang, ok := game.GetLanguage(code); ok {
+			choices = append(choices, lang)
+			fmt.Printf("%d. %s\n", len(choices), lang.Name)
+		}
+	}
+	fmt.Println()
+
+	input, err := utils.GetUserInput(i18n.T("language.prompt", current.Name))
+	if err == nil && input != "" {
+		index, err := strconv.Atoi(input)
+		if err != nil || index < 1 || index > len(choices) {
+			fmt.Println(utils.Warning(i18n.T("language.invalid_choice", current.Name)))
+		} else {
+			current = choices[index-1]
+		}
+	}
+	fmt.Println()
+
+	if config.Language != current.Code {
+		config.Language = current.Code
+		saveConfig(config)
+	}
+	return current
+}
+
+// loadWordFile loads a single word file as a source
+func loadWordFile(filename string) (*game.WordSource, string, error) {
+	wordList, err := game.LoadWordsFromFile(filename)
+	if err != nil {
+		return nil, "", err
+	}
+	return game.NewWordSource(game.SourceName(filename), filename, wordList), filename, nil
+}
+
+// loadWordDirectory loads the extra word sources from --words-dir or the words directory in the profile directory
+func loadWordDirectory() []*game.WordSource {
+	profileWords, err := game.ProfileWordsDir()
+	if err != nil {
+		return nil
+	}
+
+	dir := *wordsDirFlag
+	if dir == "" {
+		dir = profileWords
+		if _, err := os.Stat(dir); err != nil {
+			return nil
+		}
+	}
+
+	sources, err := game.LoadWordSources(dir)
+	if err != nil {
+		log.Printf("Warning: Could not load word directory: %v", err)
+		return nil
+	}
+
+	// Words added from the settings menu belong to the profile, whichever
+	// directory the other word files come from
+	if filepath.Clean(dir) != filepath.Clean(profileWords) {
+		custom := filepath.Join(profileWords, game.CustomWordsFile)
+		if _, err := os.Stat(custom); err == nil {
+			source, _, err := loadWordFile(custom)
+			if err != nil {
+				log.Printf("Warning: Could not load custom words: %v", err)
+			} else {
+				sources = append(sources, source)
+			}
+		}
+	}
+
+	for _, source := range sources {
+		fmt.Println(utils.Info(i18n.N("words.loaded", len(source.Words), len(source.Words), source.Path)))
+	}
+	return sources
 }
 
 // getDifficulty gets the difficulty level from the user
@@ -178,7 +696,7 @@ func getDifficulty() string {
 	for {
 		difficulty, err := utils.GetDifficultyInput()
 		if err != nil {
-			fmt.Printf("Error: %v\n", err)
+			fmt.Println(utils.Error(err.Error()))
 			continue
 		}
 		return difficulty
@@ -187,8 +705,47 @@ func getDifficulty() string {
 
 // playGame plays a single game and returns true if player won
 func playGame(g *game.Game) bool {
-	fmt.Print(utils.Info("Starting new game!\n"))
-	fmt.Printf("The word has %d letters.\n\n", len(g.Word))
+	// Play full-screen with single keypresses when running in a terminal,
+	// otherwise (or if the terminal goes away, or for screen readers, which
+	// can't follow a redrawn screen) one line per guess
+	if *tuiFlag && !game.AccessibleMode() {
+		if screen, err := terminal.Open(); err == nil {
+			playFullScreen(screen, g)
+		}
+	}
+	if !g.IsGameOver {
+		playLineMode(g)
+	}
+
+	// Display final game state
+	game.DisplayGameState(g)
+
+	// Show win/lose message
+	if g.IsWon {
+		printArt(assets.WinMessage())
+		game.DisplayWinMessage(g.Word)
+		return true
+	} else {
+		printArt(assets.LoseMessage())
+		game.DisplayLoseMessage(g.Word)
+		return false
+	}
+}
+
+// printArt prints a banner drawn in block letters, except in screen reader
+// mode, where it would be read out as a string of symbols
+func printArt(art string) {
+	if game.AccessibleMode() {
+		return
+	}
+	fmt.Print(art)
+}
+
+// playLineMode plays a game reading one line per guess
+func playLineMode(g *game.Game) {
+	fmt.Println(utils.Info(i18n.T("game.starting")))
+	fmt.Println(i18n.N("game.word_length", utf8.RuneCountInString(g.Word)))
+	fmt.Println()
 
 	// Game loop
 	for !g.IsGameOver {
@@ -205,26 +762,12 @@ func playGame(g *game.Game) bool {
 		game.ClearScreen()
 
 		if isCorrect {
-			fmt.Println(utils.Success(fmt.Sprintf("Great! '%c' is in the word!", letter)))
+			fmt.Println(utils.Success(i18n.T("game.correct_guess", letter)))
 		} else {
-			fmt.Println(utils.Error(fmt.Sprintf("Sorry, '%c' is not in the word.", letter)))
+			fmt.Println(utils.Error(i18n.T("game.wrong_guess", letter)))
 		}
 		fmt.Println()
 	}
-
-	// Display final game state
-	game.DisplayGameState(g)
-
-	// Show win/lose message
-	if g.IsWon {
-		fmt.Print(assets.WinMessage())
-		game.DisplayWinMessage(g.Word)
-		return true
-	} else {
-		fmt.Print(assets.LoseMessage())
-		game.DisplayLoseMessage(g.Word)
-		return false
-	}
 }
 
 // getLetterInput gets a valid letter input from the user
@@ -232,14 +775,14 @@ func getLetterInput(g *game.Game) rune {
 	for {
 		letter, err := utils.GetLetterInput()
 		if err != nil {
-			fmt.Println(utils.Error("Invalid input: " + err.Error()))
-			fmt.Println("Please enter a single letter (A-Z)")
+			fmt.Println(utils.Error(i18n.T("input.invalid", err)))
+			fmt.Println(i18n.T("input.single_letter", utils.DescribeAlphabet()))
 			continue
 		}
 
 		// Check if letter was already guessed
 		if g.GuessedLetters[letter] {
-			fmt.Println(utils.Warning(fmt.Sprintf("You already guessed '%c'! Try a different letter.", letter)))
+			fmt.Println(utils.Warning(i18n.T("game.already_guessed", letter)))
 			continue
 		}
 
@@ -249,26 +792,34 @@ func getLetterInput(g *game.Game) rune {
 
 // addCustomWord adds a custom word to the word list
 func addCustomWord(wordList *game.WordList) {
-	word, err := utils.GetUserInput("Enter a word to add (3+ letters): ")
+	word, err := utils.GetUserInput(i18n.T("words.add_prompt"))
 	if err != nil {
-		fmt.Println(utils.Error("Error reading input: " + err.Error()))
+		fmt.Println(utils.Error(i18n.T("input.read_error", err)))
 		return
 	}
 
 	if !utils.IsValidWord(word) {
-		fmt.Println(utils.Error("Invalid word. Word must be 3+ letters and contain only alphabetic characters."))
+		fmt.Println(utils.Error(i18n.T("words.add_invalid")))
+		return
+	}
+
+	if wordList.IsBlocked(word) {
+		fmt.Println(utils.Error(i18n.T("words.add_blocked")))
 		return
 	}
 
 	wordList.AddWord(word)
-	fmt.Println(utils.Success(fmt.Sprintf("Added '%s' to word list!", strings.ToUpper(word))))
+	if err := game.SaveCustomWord(word); err != nil {
+		log.Printf("Warning: Could not save custom word: %v", err)
+	}
+	fmt.Println(utils.Success(i18n.T("words.added", strings.ToUpper(word))))
 }
 
 // removeWord removes a word from the word list
 func removeWord(wordList *game.WordList) {
-	word, err := utils.GetUserInput("Enter a word to remove: ")
+	word, err := utils.GetUserInput(i18n.T("words.remove_prompt"))
 	if err != nil {
-		fmt.Println(utils.Error("Error reading input: " + err.Error()))
+		fmt.Println(utils.Error(i18n.T("input.read_error", err)))
 		return
 	}
 
@@ -276,22 +827,25 @@ func removeWord(wordList *game.WordList) {
 	wordList.RemoveWord(word)
 
 	if wordList.GetWordCount() < originalCount {
-		fmt.Println(utils.Success(fmt.Sprintf("Removed '%s' from word list!", strings.ToUpper(word))))
+		if err := game.SaveRemovedWord(word); err != nil {
+			log.Printf("Warning: Could not save removed word: %v", err)
+		}
+		fmt.Println(utils.Success(i18n.T("words.removed", strings.ToUpper(word))))
 	} else {
-		fmt.Println(utils.Warning(fmt.Sprintf("Word '%s' not found in word list.", strings.ToUpper(word))))
+		fmt.Println(utils.Warning(i18n.T("words.not_found", strings.ToUpper(word))))
 	}
 }
 
 // listWords displays all words in the word list
 func listWords(wordList *game.WordList) {
-	fmt.Printf(utils.Bold("📋 WORD LIST (%d words)\n"), wordList.GetWordCount())
+	fmt.Println(utils.Bold(i18n.N("words.list_title", wordList.GetWordCount())))
 	fmt.Println("===============")
 
 	words := wordList.Words
 	for i, word := range words {
 		fmt.Printf("%3d. %s\n", i+1, word)
 		if (i+1)%20 == 0 && i < len(words)-1 {
-			more, err := utils.GetYesNoInput("Show more words?")
+			more, err := utils.GetYesNoInput(i18n.T("words.show_more"))
 			if err != nil || !more {
 				break
 			}
@@ -302,33 +856,287 @@ func listWords(wordList *game.WordList) {
 	utils.WaitForEnter()
 }
 
+// toggleWordSources lists the word sources and lets the user enable or disable them
+func toggleWordSources(wordList *game.WordList, config *game.Config) {
+	for {
+		fmt.Println(utils.Bold(i18n.T("sources.title")))
+		fmt.Println("===============")
+		for i, source := range wordList.Sources {
+			state := utils.Green(i18n.T("sources.enabled"))
+			if !source.Enabled {
+				state = utils.Red(i18n.T("sources.disabled"))
+			}
+			fmt.Printf("%2d. %-15s %s  %s\n", i+1, source.Name, i18n.N("sources.word_count", len(source.Words)), state)
+		}
+		fmt.Println(i18n.N("sources.playing_with", wordList.GetWordCount()))
+		fmt.Println()
+
+		input, err := utils.GetUserInput(i18n.T("sources.prompt"))
+		if err != nil || input == "" {
+			return
+		}
+
+		index, err := strconv.Atoi(input)
+		if err != nil || index < 1 || index > len(wordList.Sources) {
+			fmt.Println(utils.Error(i18n.T("menu.invalid_choice")))
+			continue
+		}
+
+		source := wordList.Sources[index-1]
+		if source.Enabled && enabledSourceCount(wordList) == 1 {
+			fmt.Println(utils.Warning(i18n.T("sources.keep_one")))
+			continue
+		}
+		_ = wordList.SetSourceEnabled(source.Name, !source.Enabled) //nolint:errcheck // Source name comes from the list
+		config.SetSourceDisabled(source.Name, !source.Enabled)
+		saveConfig(config)
+		fmt.Println()
+	}
+}
+
+// toggleFamilyFriendly switches the built-in blocklist on or off
+func toggleFamilyFriendly(wordList *game.WordList, config *game.Config) {
+	config.FamilyFriendly = !config.FamilyFriendly
+	saveConfig(config)
+
+	filtered := applyContentFilter(wordList, config)
+	fmt.Println(utils.Success(i18n.N("settings.family_friendly_changed", filtered, onOff(config.FamilyFriendly), filtered)))
+}
+
+// applyContentFilter applies the user's blocklist, plus the built-in one in
+// family-friendly mode, and returns how many words were filtered out
+func applyContentFilter(wordList *game.WordList, config *game.Config) int {
+	blocklist, err := game.LoadUserBlocklist(config.FamilyFriendly)
+	if err != nil {
+		log.Printf("Warning: Could not load blocklist: %v", err)
+	}
+	return len(wordList.SetBlocklist(blocklist))
+}
+
+// chooseLocale lets the player pick the interface language and remembers it
+func chooseLocale(config *game.Config) {
+	locales := i18n.Locales()
+	for i, locale := range locales {
+		fmt.Printf("%d. %s\n", i+1, locale)
+	}
+	fmt.Println()
+
+	input, err := utils.GetUserInput(i18n.T("locale.prompt", i18n.Locale()))
+	if err != nil || input == "" {
+		return
+	}
+
+	index, err := strconv.Atoi(input)
+	if err != nil || index < 1 || index > len(locales) {
+		fmt.Println(utils.Error(i18n.T("menu.invalid_choice")))
+		return
+	}
+
+	_ = i18n.SetLocale(locales[index-1]) //nolint:errcheck // Locale comes from the embedded list
+	config.Locale = locales[index-1]
+	saveConfig(config)
+	fmt.Println(utils.Success(i18n.T("locale.changed", config.Locale)))
+}
+
+// describeRetention summarizes the history retention settings
+func describeRetention(config *game.Config) string {
+	var parts []string
+	if config.HistoryMaxGames > 0 {
+		parts = append(parts, i18n.N("retention.games", config.HistoryMaxGames))
+	}
+	if config.HistoryMaxDays > 0 {
+		parts = append(parts, i18n.N("retention.days", config.HistoryMaxDays))
+	}
+	if len(parts) == 0 {
+		return i18n.T("retention.keep_all")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// configureHistoryRetention asks how much game history to keep and prunes the log
+func configureHistoryRetention(config *game.Config) {
+	maxGames, ok := getCountInput(i18n.T("retention.games_prompt", config.HistoryMaxGames), config.HistoryMaxGames)
+	if !ok {
+		return
+	}
+	maxDays, ok := getCountInput(i18n.T("retention.days_prompt", config.HistoryMaxDays), config.HistoryMaxDays)
+	if !ok {
+		return
+	}
+
+	config.HistoryMaxGames, config.HistoryMaxDays = maxGames, maxDays
+	saveConfig(config)
+	fmt.Println(utils.Success(i18n.T("retention.saved")))
+
+	removed, err := game.PruneHistory(maxGames, maxDays)
+	if err != nil {
+		log.Printf("Warning: Could not prune history: %v", err)
+	} else if removed > 0 {
+		fmt.Println(utils.Info(i18n.N("retention.pruned", removed)))
+	}
+}
+
+// getCountInput reads a number of 0 or more, returning current on Enter
+func getCountInput(prompt string, current int) (int, bool) {
+	input, err := utils.GetUserInput(prompt)
+	if err != nil {
+		return 0, false
+	}
+	if input == "" {
+		return current, true
+	}
+
+	count, err := strconv.Atoi(input)
+	if err != nil || count < 0 {
+		fmt.Println(utils.Error(i18n.T("retention.invalid")))
+		return 0, false
+	}
+	return count, true
+}
+
+// saveConfig saves settings, warning if that fails
+func saveConfig(config *game.Config) {
+	if err := config.SaveConfig(); err != nil {
+		log.Printf("Warning: Could not save settings: %v", err)
+	}
+}
+
+// onOff formats a setting for display
+func onOff(enabled bool) string {
+	if enabled {
+		return i18n.T("settings.on")
+	}
+	return i18n.T("settings.off")
+}
+
+// enabledSourceCount returns how many word sources are currently enabled
+func enabledSourceCount(wordList *game.WordList) int {
+	count := 0
+	for _, source := range wordList.Sources {
+		if source.Enabled {
+			count++
+		}
+	}
+	return count
+}
+
+// showTransferMenu exports statistics and history to files, or imports them
+func showTransferMenu(stats *game.Statistics) {
+	fmt.Println(utils.Bold(i18n.T("menu.transfer.title")))
+	fmt.Println("=================")
+	fmt.Println("1. " + i18n.T("menu.transfer.export_stats_json"))
+	fmt.Println("2. " + i18n.T("menu.transfer.export_stats_csv"))
+	fmt.Println("3. " + i18n.T("menu.transfer.export_history_csv"))
+	fmt.Println("4. " + i18n.T("menu.transfer.import"))
+	fmt.Println("5. " + i18n.T("menu.settings.back"))
+	fmt.Println()
+
+	choice, err := utils.GetUserInput(i18n.T("menu.choice", 1, 5))
+	if err != nil {
+		return
+	}
+
+	switch strings.TrimSpace(choice) {
+	case "1":
+		exportFromMenu("hangman-stats.json", func(w io.Writer, history []game.HistoryEntry) error {
+			return game.ExportStatistics(w, game.FormatJSON, stats, history)
+		})
+	case "2":
+		exportFromMenu("hangman-stats.csv", func(w io.Writer, _ []game.HistoryEntry) error {
+			return game.ExportStatistics(w, game.FormatCSV, stats, nil)
+		})
+	case "3":
+		exportFromMenu("hangman-history.csv", func(w io.Writer, history []game.HistoryEntry) error {
+			return game.ExportHistory(w, game.FormatCSV, history)
+		})
+	case "4":
+		importFromMenu(stats)
+	case "5", "":
+		return
+	default:
+		fmt.Println(utils.Error(i18n.T("menu.invalid_choice")))
+	}
+}
+
+// exportFromMenu asks for a file name and writes an export to it
+func exportFromMenu(defaultName string, write func(w io.Writer, history []game.HistoryEntry) error) {
+	path, err := utils.GetUserInput(i18n.T("transfer.file_prompt", defaultName))
+	if err != nil {
+		return
+	}
+	if path == "" {
+		path = defaultName
+	}
+
+	history, err := game.LoadHistory()
+	if err == nil {
+		err = exportToFile(path, func(w io.Writer) error {
+			return write(w, history)
+		})
+	}
+	if err != nil {
+		fmt.Println(utils.Error(i18n.T("transfer.export_error", err)))
+		return
+	}
+	fmt.Println(utils.Success(i18n.T("transfer.exported", path)))
+}
+
+// importFromMenu asks for a file and merges its statistics into the profile
+func importFromMenu(stats *game.Statistics) {
+	path, err := utils.GetUserInput(i18n.T("transfer.import_prompt"))
+	if err != nil || path == "" {
+		return
+	}
+
+	other, history, err := game.ReadImport(path)
+	if err != nil {
+		fmt.Println(utils.Error(i18n.T("transfer.import_error", err)))
+		return
+	}
+	result, err := stats.Import(other, history)
+	if err == nil {
+		err = stats.SaveStatistics()
+	}
+	if err != nil {
+		fmt.Println(utils.Error(i18n.T("transfer.import_error", err)))
+		return
+	}
+
+	fmt.Println(utils.Success(i18n.N("transfer.imported", result.Games)))
+	if result.Duplicates > 0 {
+		fmt.Println(utils.Info(i18n.N("transfer.duplicates", result.Duplicates)))
+	}
+	if result.Untracked > 0 {
+		fmt.Println(utils.Warning(i18n.N("transfer.untracked", result.Untracked)))
+	}
+}
+
 // resetStatistics resets all game statistics
 func resetStatistics(stats *game.Statistics) {
-	confirm, err := utils.GetYesNoInput("Are you sure you want to reset all statistics? This cannot be undone.")
+	confirm, err := utils.GetYesNoInput(i18n.T("stats.reset_confirm"))
 	if err != nil {
-		fmt.Println(utils.Error("Error reading input: " + err.Error()))
+		fmt.Println(utils.Error(i18n.T("input.read_error", err)))
 		return
 	}
 
 	if confirm {
 		stats.ResetStatistics()
 		if err := stats.SaveStatistics(); err != nil {
-			fmt.Println(utils.Error("Error saving statistics: " + err.Error()))
+			fmt.Println(utils.Error(i18n.T("stats.save_error", err)))
 		} else {
-			fmt.Println(utils.Success("Statistics reset successfully!"))
+			fmt.Println(utils.Success(i18n.T("stats.reset_done")))
 		}
 	} else {
-		fmt.Println(utils.Info("Statistics reset canceled."))
+		fmt.Println(utils.Info(i18n.T("stats.reset_canceled")))
 	}
 }
 
 // printFinalStats prints final statistics when exiting
 func printFinalStats(stats *game.Statistics) {
 	if stats.GamesPlayed > 0 {
-		fmt.Printf("Final Statistics - Games: %d, Won: %d, Win Rate: %.1f%%\n",
-			stats.GamesPlayed, stats.GamesWon, stats.GetWinRate())
+		fmt.Println(i18n.T("stats.final", stats.GamesPlayed, stats.GamesWon, stats.GetWinRate()))
 		if stats.LongestStreak > 0 {
-			fmt.Printf("Your best winning streak was: %d games!\n", stats.LongestStreak)
+			fmt.Println(i18n.N("stats.best_streak", stats.LongestStreak))
 		}
 	}
 }
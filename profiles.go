@@ -0,0 +1,265 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/VinayBhutange/hangman-go/game"
+	"github.com/VinayBhutange/hangman-go/i18n"
+	"github.com/VinayBhutange/hangman-go/utils"
+)
+
+// chooseProfile activates the profile from --profile, or asks who is playing
+// when there are several profiles, defaulting to the last one played
+func chooseProfile(config *game.Config) {
+	name := *profileFlag
+	if name == "" {
+		name = config.Profile
+		if name == "" {
+			name = game.DefaultProfile
+		}
+
+		profiles, err := game.ListProfiles()
+		if err != nil {
+			log.Printf("Warning: Could not list profiles: %v", err)
+		}
+		if !contains(profiles, name) {
+			name = game.DefaultProfile
+		}
+		if len(profiles) > 1 {
+			fmt.Println(utils.Bold(i18n.T("profile.title")))
+			fmt.Println("===========")
+			name = pickProfile(profiles, name)
+		}
+	}
+
+	activateProfile(name, config)
+}
+
+// activateProfile switches to a profile and remembers it, falling back to the
+// default profile if it can't be used
+func activateProfile(name string, config *game.Config) {
+	if err := game.SetProfile(name); err != nil {
+		log.Printf("Warning: Could not use profile %q: %v", name, err)
+		name = game.DefaultProfile
+		_ = game.SetProfile(name) //nolint:errcheck // The default profile is the data directory itself
+	}
+
+	profile := name
+	if profile == game.DefaultProfile {
+		profile = ""
+	}
+	if config.Profile != profile {
+		config.Profile = profile
+		saveConfig(config)
+	}
+}
+
+// pickProfile lists the profiles with an option to create a new one and returns
+// the chosen name, or current when nothing valid is chosen
+func pickProfile(profiles []string, current string) string {
+	for i, profile := range profiles {
+		marker := " "
+		if profile == current {
+			marker = "*"
+		}
+		fmt.Printf("%d.%s %s\n", i+1, marker, profile)
+	}
+	fmt.Printf("%d.  %s\n", len(profiles)+1, i18n.T("menu.profiles.new"))
+	fmt.Println()
+
+	input, err := utils.GetUserInput(i18n.T("profile.prompt", current))
+	if err != nil || input == "" {
+		return current
+	}
+
+	index, err := strconv.Atoi(input)
+	switch {
+	case err != nil || index < 1 || index > len(profiles)+1:
+		fmt.Println(utils.Error(i18n.T("menu.invalid_choice")))
+		return current
+	case index == len(profiles)+1:
+		if name, ok := newProfile(); ok {
+			return name
+		}
+		return current
+	default:
+		return profiles[index-1]
+	}
+}
+
+// newProfile asks for a profile name and creates it
+func newProfile() (string, bool) {
+	name, err := utils.GetUserInput(i18n.T("profile.name_prompt"))
+	if err != nil {
+		fmt.Println(utils.Error(i18n.T("input.read_error", err)))
+		return "", false
+	}
+
+	name = strings.TrimSpace(name)
+	if err := game.ValidateProfileName(name); err != nil {
+		fmt.Println(utils.Error(i18n.T("profile.invalid_name")))
+		return "", false
+	}
+	if err := game.CreateProfile(name); err != nil {
+		fmt.Println(utils.Error(i18n.T("profile.create_error", err)))
+		return "", false
+	}
+	return name, true
+}
+
+// showProfilesMenu lets the player switch, create and compare profiles
+func showProfilesMenu(wordList *game.WordList, stats *game.Statistics, achievements *game.AchievementState, config *game.Config, lang *game.Language) {
+	for {
+		fmt.Println(utils.Bold(i18n.T("menu.profiles.title")))
+		fmt.Println("==========")
+		fmt.Println("1. " + i18n.T("menu.profiles.switch"))
+		fmt.Println("2. " + i18n.T("menu.profiles.new"))
+		fmt.Println("3. " + i18n.T("menu.profiles.compare"))
+		fmt.Println("4. " + i18n.T("menu.profiles.leaderboard"))
+		fmt.Println("5. " + i18n.T("menu.settings.back"))
+		fmt.Println()
+
+		choice, err := utils.GetUserInput(i18n.T("menu.choice", 1, 5))
+		if err != nil {
+			return
+		}
+
+		switch strings.TrimSpace(choice) {
+		case "1":
+			profiles, err := game.ListProfiles()
+			if err != nil {
+				log.Printf("Warning: Could not list profiles: %v", err)
+			}
+			name := pickProfile(profiles, game.ActiveProfile())
+			switchProfile(name, wordList, stats, achievements, config, lang)
+		case "2":
+			if name, ok := newProfile(); ok {
+				switchProfile(name, wordList, stats, achievements, config, lang)
+			}
+		case "3":
+			compareProfiles()
+		case "4":
+			showLeaderboard()
+		case "5", "":
+			return
+		default:
+			fmt.Println(utils.Error(i18n.T("menu.invalid_choice")))
+		}
+		fmt.Println()
+	}
+}
+
+// switchProfile makes another profile active, replacing the statistics,
+// achievements and word list in place with the profile's own
+func switchProfile(name string, wordList *game.WordList, stats *game.Statistics, achievements *game.AchievementState, config *game.Config, lang *game.Language) {
+	previous := game.ActiveProfile()
+	if name == previous {
+		return
+	}
+
+	activateProfile(name, config)
+	profileStats, err := openStatistics(config)
+	if err != nil {
+		fmt.Println(utils.Error(i18n.T("stats.newer_version", err)))
+		activateProfile(previous, config)
+		return
+	}
+
+	*stats = *profileStats
+	*achievements = *openAchievements()
+	*wordList = *loadWordList(lang, config)
+	fmt.Println(utils.Success(i18n.T("profile.switched", game.ActiveProfile())))
+}
+
+// compareProfiles shows every profile's results side by side
+func compareProfiles() {
+	profiles, err := game.ListProfiles()
+	if err != nil {
+		log.Printf("Warning: Could not list profiles: %v", err)
+	}
+
+	fmt.Println(utils.Bold(i18n.T("profile.compare_title")))
+	fmt.Println("=====================")
+	fmt.Printf("  %-20s %8s %8s %10s %8s %12s\n",
+		i18n.T("profile.column.profile"), i18n.T("profile.column.games"), i18n.T("profile.column.won"),
+		i18n.T("profile.column.win_rate"), i18n.T("profile.column.streak"), i18n.T("profile.column.best_streak"))
+
+	for _, profile := range profiles {
+		stats, err := game.LoadProfileStatistics(profile)
+		if err != nil {
+			fmt.Println(utils.Warning(i18n.T("profile.load_error", profile, err)))
+			continue
+		}
+
+		marker := " "
+		if profile == game.ActiveProfile() {
+			marker = "*"
+		}
+		fmt.Printf("%s %-20s %8d %8d %9.1f%% %8d %12d\n", marker, profile,
+			stats.GamesPlayed, stats.GamesWon, stats.GetWinRate(), stats.CurrentStreak, stats.LongestStreak)
+	}
+
+	fmt.Println()
+	utils.WaitForEnter()
+}
+
+// hardestWordCount is the number of words the leaderboard lists by rating
+const hardestWordCount = 5
+
+// showLeaderboard ranks the profiles by rating and lists the hardest words
+func showLeaderboard() {
+	entries, skipped, err := game.Leaderboard()
+	if err != nil {
+		fmt.Println(utils.Error(i18n.T("leaderboard.load_error", err)))
+		return
+	}
+	for _, err := range skipped {
+		fmt.Println(utils.Warning(i18n.T("leaderboard.skipped", err)))
+	}
+
+	fmt.Println(utils.Bold(i18n.T("leaderboard.title")))
+	fmt.Println("================")
+	fmt.Printf("  %4s %-20s %8s %8s %10s %8s\n",
+		"#", i18n.T("profile.column.profile"), i18n.T("leaderboard.column.rating"), i18n.T("profile.column.games"),
+		i18n.T("profile.column.win_rate"), i18n.T("profile.column.streak"))
+	for i, entry := range entries {
+		marker := " "
+		if entry.Profile == game.ActiveProfile() {
+			marker = "*"
+		}
+		fmt.Printf("%s %4d %-20s %8.0f %8d %9.1f%% %8d\n", marker, i+1, entry.Profile,
+			entry.Rating.Rating, entry.Rating.Games, entry.WinRate, entry.CurrentStreak)
+	}
+
+	ratings, err := game.LoadRatings()
+	if err == nil && len(ratings.Words) > 0 {
+		fmt.Println()
+		fmt.Println(utils.Bold(i18n.T("leaderboard.hardest_words")))
+		for _, word := range ratings.HardestWords(hardestWordCount) {
+			rating := ratings.Word(word)
+			fmt.Printf("  %-20s %6.0f  %s\n", word, rating.Rating, i18n.N("leaderboard.word_games", rating.Games, rating.Games))
+		}
+	}
+
+	fmt.Println()
+	utils.WaitForEnter()
+}
+
+// showRatingChange shows how the last game moved the player's rating
+func showRatingChange(change game.RatingChange) {
+	delta := change.PlayerAfter - change.PlayerBefore
+	fmt.Println(i18n.T("leaderboard.rating_change", change.PlayerAfter, delta, change.WordAfter))
+}
+
+// contains reports whether a list holds a string
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
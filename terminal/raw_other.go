@@ -0,0 +1,26 @@
+//go:build !linux
+
+package terminal
+
+// state is the terminal mode to restore
+type state struct{}
+
+// isTerminal reports false: raw mode is only implemented on Linux
+func isTerminal(_ int) bool {
+	return false
+}
+
+// makeRaw is not supported on this platform
+func makeRaw(_ int) (*state, error) {
+	return nil, ErrUnsupported
+}
+
+// restore does nothing on platforms without raw mode
+func restore(_ int, _ *state) error {
+	return nil
+}
+
+// size is not supported on this platform
+func size(_ int) (int, int, error) {
+	return 0, 0, ErrUnsupported
+}
@@ -0,0 +1,9 @@
+//go:build !aix && !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !solaris
+
+package terminal
+
+import "os"
+
+// exitSignals are the signals that end the program, restoring the terminal
+// first. Other platforms only have the portable interrupt.
+var exitSignals = []os.Signal{os.Interrupt}
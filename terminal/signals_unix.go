@@ -0,0 +1,11 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+
+package terminal
+
+import (
+	"os"
+	"syscall"
+)
+
+// exitSignals are the signals that end the program, restoring the terminal first
+var exitSignals = []os.Signal{syscall.SIGTERM, syscall.SIGHUP}
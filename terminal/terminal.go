@@ -0,0 +1,171 @@
+// Package terminal provides a full-screen terminal with single-keypress input,
+// redrawn in place and restored however the program ends.
+package terminal
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Keys with special meaning returned by ReadKey
+const (
+	KeyNone      rune = 0    // A key without a character, such as an arrow key
+	KeyInterrupt rune = 3    // Ctrl+C
+	KeyEOF       rune = 4    // Ctrl+D
+	KeyEnter     rune = '\r' // Enter
+	KeyEscape    rune = 27   // Esc on its own
+)
+
+// ANSI sequences for the alternate screen, the cursor and clearing
+const (
+	enterScreen = "\033[?1049h\033[?25l" // Switch to the alternate screen and hide the cursor
+	leaveScreen = "\033[?25h\033[?1049l" // Show the cursor and go back to the normal screen
+	home        = "\033[H"               // Move the cursor to the top left corner
+	clearLine   = "\033[K"               // Clear to the end of the line
+	clearBelow  = "\033[J"               // Clear to the end of the screen
+)
+
+// defaultWidth is the width assumed when the terminal size is unknown
+const defaultWidth = 80
+
+// ErrUnsupported is returned by Open on platforms without raw mode support
+var ErrUnsupported = errors.New("full-screen mode is not supported on this platform")
+
+// ErrNotTerminal is returned by Open when input or output isn't a terminal
+var ErrNotTerminal = errors.New("not a terminal")
+
+// Screen is a terminal in full-screen mode
+type Screen struct {
+	in  io.Reader
+	out io.Writer
+	fd  int // Terminal file descriptor, -1 when not attached to a terminal
+
+	mu      sync.Mutex
+	state   *state
+	closed  bool
+	signals chan os.Signal
+}
+
+// IsTerminal reports whether a file is a terminal that supports full-screen mode
+func IsTerminal(f *os.File) bool {
+	return isTerminal(int(f.Fd()))
+}
+
+// Open switches the terminal on stdin and stdout to full-screen mode with
+// single-keypress input. Close must be called to restore it; Open also
+// restores it if the process is terminated by a signal.
+func Open() (*Screen, error) {
+	if !IsTerminal(os.Stdin) || !IsTerminal(os.Stdout) {
+		return nil, ErrNotTerminal
+	}
+
+	fd := int(os.Stdin.Fd())
+	old, err := makeRaw(fd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to switch the terminal to raw mode: %w", err)
+	}
+
+	s := &Screen{in: os.Stdin, out: os.Stdout, fd: fd, state: old}
+	s.signals = make(chan os.Signal, 1)
+	signal.Notify(s.signals, exitSignals...)
+	go func() {
+		if _, ok := <-s.signals; ok {
+			_ = s.Close() //nolint:errcheck // Exiting anyway
+			os.Exit(1)
+		}
+	}()
+
+	fmt.Fprint(s.out, enterScreen)
+	return s, nil
+}
+
+// NewScreen creates a screen on any reader and writer, without changing
+// terminal modes, such as for tests
+func NewScreen(in io.Reader, out io.Writer) *Screen {
+	return &Screen{in: in, out: out, fd: -1}
+}
+
+// Close restores the terminal to the mode it was in before Open. It is safe
+// to call more than once, such as from a deferred call and a panic handler.
+func (s *Screen) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+
+	if s.signals != nil {
+		signal.Stop(s.signals)
+		close(s.signals)
+	}
+	if s.state == nil {
+		return nil
+	}
+
+	fmt.Fprint(s.out, leaveScreen)
+	if err := restore(s.fd, s.state); err != nil {
+		return fmt.Errorf("failed to restore the terminal: %w", err)
+	}
+	return nil
+}
+
+// Width returns the number of columns of the terminal
+func (s *Screen) Width() int {
+	if s.fd >= 0 {
+		if width, _, err := size(s.fd); err == nil && width > 0 {
+			return width
+		}
+	}
+	return defaultWidth
+}
+
+// Draw replaces the screen's contents with frame, clearing whatever the
+// previous frame left beyond it
+func (s *Screen) Draw(frame string) error {
+	var sb strings.Builder
+	sb.WriteString(home)
+	sb.WriteString(strings.ReplaceAll(frame, "\n", clearLine+"\n"))
+	sb.WriteString(clearLine + clearBelow)
+
+	if _, err := io.WriteString(s.out, sb.String()); err != nil {
+		return fmt.Errorf("failed to draw the screen: %w", err)
+	}
+	return nil
+}
+
+// ReadKey waits for a keypress and returns its character, KeyNone for keys
+// without one, or one of the other Key constants
+func (s *Screen) ReadKey() (rune, error) {
+	buf := make([]byte, 16)
+	n, err := s.in.Read(buf)
+	if err != nil {
+		return KeyNone, err
+	}
+
+	// Arrow and function keys send escape sequences; only Esc on its own counts
+	if buf[0] == byte(KeyEscape) && n > 1 {
+		return KeyNone, nil
+	}
+
+	// A character can arrive split over several reads
+	for !utf8.FullRune(buf[:n]) && n < len(buf) {
+		more, err := s.in.Read(buf[n:])
+		if err != nil {
+			return KeyNone, err
+		}
+		n += more
+	}
+
+	key, _ := utf8.DecodeRune(buf[:n])
+	if key == utf8.RuneError {
+		return KeyNone, nil
+	}
+	return key, nil
+}
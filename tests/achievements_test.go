@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VinayBhutange/hangman-go/game"
+)
+
+// unlockedIDs returns the IDs of achievements
+func unlockedIDs(achievements []game.Achievement) map[string]bool {
+	ids := make(map[string]bool)
+	for _, a := range achievements {
+		ids[a.ID] = true
+	}
+	return ids
+}
+
+func TestBuiltinAchievements(t *testing.T) {
+	for _, a := range game.Achievements() {
+		if a.Name() == "achievement."+a.ID || a.Icon == "" {
+			t.Errorf("Achievement %s needs an icon and a name in the catalog", a.ID)
+		}
+		if a.Description() == "achievement."+a.ID+".description" {
+			t.Errorf("Achievement %s needs a description in the catalog", a.ID)
+		}
+	}
+}
+
+func TestParseAchievementsRejectsBadDefinitions(t *testing.T) {
+	for _, definitions := range []string{
+		`[{"id": "x", "rule": "moon_landing"}]`,
+		`[{"id": "x", "rule": "win_streak"}]`,
+		`[{"id": "x", "rule": "difficulty_win"}]`,
+		`[{"id": "x", "rule": "flawless_win"}, {"id": "x", "rule": "flawless_win"}]`,
+	} {
+		if _, err := game.ParseAchievements([]byte(definitions)); err == nil {
+			t.Errorf("Expected %s to be rejected", definitions)
+		}
+	}
+}
+
+func TestEvaluateAchievements(t *testing.T) {
+	definitions, err := game.ParseAchievements([]byte(`[
+		{"id": "first", "rule": "games_won", "threshold": 1},
+		{"id": "gasp", "rule": "last_guess_win"},
+		{"id": "hard", "rule": "difficulty_win", "difficulty": "hard"},
+		{"id": "flawless", "rule": "flawless_win"},
+		{"id": "daily", "rule": "daily_streak", "threshold": 3}
+	]`))
+	if err != nil {
+		t.Fatalf("ParseAchievements failed: %v", err)
+	}
+	state := game.NewAchievementState(definitions)
+	stats := game.NewStatistics()
+
+	// A win with five wrong guesses out of six
+	g := game.NewGameWithSeed([]string{testWordGo}, 1)
+	for _, letter := range "ABCDEGO" {
+		g.GuessLetter(letter)
+	}
+	unlocked := unlockedIDs(state.Evaluate(stats, stats.RecordGame(g, game.DifficultyHard)))
+	if !unlocked["first"] || !unlocked["gasp"] || !unlocked["hard"] || unlocked["flawless"] || unlocked["daily"] {
+		t.Errorf("Unexpected achievements unlocked: %v", unlocked)
+	}
+
+	// Unlocked achievements are not announced again
+	if again := state.Evaluate(stats, stats.RecordGame(playWonGame(), game.DifficultyHard)); len(again) != 0 {
+		t.Errorf("Expected nothing new, got %v", unlockedIDs(again))
+	}
+
+	// Three days in a row, starting over after a gap
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.Local)
+	for i, day := range []int{0, 5, 6, 6} {
+		entry := game.NewHistoryEntry(playLostGame(), game.DifficultyEasy)
+		entry.PlayedAt = start.AddDate(0, 0, day)
+		if got := unlockedIDs(state.Evaluate(stats, entry)); got["daily"] {
+			t.Errorf("Game %d: daily streak unlocked after %d days", i+1, state.DayStreak)
+		}
+	}
+	entry := game.NewHistoryEntry(playLostGame(), game.DifficultyEasy)
+	entry.PlayedAt = start.AddDate(0, 0, 7)
+	if got := unlockedIDs(state.Evaluate(stats, entry)); !got["daily"] {
+		t.Errorf("Expected the daily streak on the third day in a row, streak is %d", state.DayStreak)
+	}
+}
+
+func TestAchievementsPersist(t *testing.T) {
+	setDataEnv(t)
+
+	state, err := game.LoadAchievements()
+	if err != nil {
+		t.Fatalf("LoadAchievements failed: %v", err)
+	}
+	stats := game.NewStatistics()
+	entry := stats.RecordGame(playWonGame(), game.DifficultyEasy)
+	if unlocked := unlockedIDs(state.Evaluate(stats, entry)); !unlocked["first_win"] {
+		t.Fatalf("Expected first_win to unlock, got %v", unlocked)
+	}
+	if err := state.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	loaded, err := game.LoadAchievements()
+	if err != nil {
+		t.Fatalf("LoadAchievements failed: %v", err)
+	}
+	when, ok := loaded.IsUnlocked("first_win")
+	if !ok || !when.Equal(entry.PlayedAt) {
+		t.Errorf("Expected first_win unlocked at %v, got %v (%v)", entry.PlayedAt, when, ok)
+	}
+	if len(loaded.Definitions()) != len(game.Achievements()) {
+		t.Error("Expected loaded achievements to know the built-in definitions")
+	}
+}
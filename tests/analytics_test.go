@@ -0,0 +1,45 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/VinayBhutange/hangman-go/game"
+)
+
+func TestAnalyzeHistory(t *testing.T) {
+	history := []game.HistoryEntry{
+		game.NewHistoryEntry(playWonGame(), game.DifficultyEasy),  // X G O on GO
+		game.NewHistoryEntry(playLostGame(), game.DifficultyEasy), // A B C D E F on GO
+		{Word: "TIGER", Guesses: "EXT", Result: game.ResultLost},
+	}
+
+	analytics := game.AnalyzeHistory(history)
+	if analytics.Games != 3 {
+		t.Errorf("Expected 3 games, got %d", analytics.Games)
+	}
+
+	x := analytics.Letter('X')
+	if x.Guessed != 2 || x.Hits != 0 || x.FirstGuesses != 1 || x.AveragePosition() != 1.5 {
+		t.Errorf("Unexpected stats for X: %+v (average position %.1f)", x, x.AveragePosition())
+	}
+
+	e := analytics.Letter('E')
+	if e.Guessed != 2 || e.Hits != 1 || e.HitRate() != 50 || e.FirstGuesses != 1 {
+		t.Errorf("Unexpected stats for E: %+v", e)
+	}
+
+	if z := analytics.Letter('Z'); z.Guessed != 0 || z.HitRate() != 0 {
+		t.Errorf("Expected no stats for an unguessed letter, got %+v", z)
+	}
+
+	if len(analytics.Lengths) != 2 {
+		t.Fatalf("Expected 2 word lengths, got %+v", analytics.Lengths)
+	}
+	short, long := analytics.Lengths[0], analytics.Lengths[1]
+	if short.Length != 2 || short.Played != 2 || short.WinRate() != 50 {
+		t.Errorf("Unexpected stats for 2-letter words: %+v", short)
+	}
+	if long.Length != 5 || long.Played != 1 || long.Won != 0 {
+		t.Errorf("Unexpected stats for 5-letter words: %+v", long)
+	}
+}
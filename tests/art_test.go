@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/VinayBhutange/hangman-go/assets"
+	"github.com/VinayBhutange/hangman-go/game"
+	"github.com/VinayBhutange/hangman-go/utils"
+)
+
+func TestParseArtPack(t *testing.T) {
+	data := "# A comment\n# Another one\n\nA\n---\n\nB\nB\n\n---\nC\nC\nC\n"
+	pack, err := game.ParseArtPack("test", []byte(data))
+	if err != nil {
+		t.Fatalf("ParseArtPack failed: %v", err)
+	}
+
+	// Shorter stages are padded at the top to the tallest stage's height
+	want := []string{"\n\nA", "\nB\nB", "C\nC\nC"}
+	if len(pack.Stages) != len(want) {
+		t.Fatalf("Expected %d stages, got %q", len(want), pack.Stages)
+	}
+	for i, stage := range want {
+		if pack.Stages[i] != stage {
+			t.Errorf("Stage %d: expected %q, got %q", i, stage, pack.Stages[i])
+		}
+	}
+
+	for _, bad := range []string{"only one stage", "A\n---\n\n---\nC"} {
+		if _, err := game.ParseArtPack("bad", []byte(bad)); err == nil {
+			t.Errorf("Expected %q to be rejected", bad)
+		}
+	}
+}
+
+func TestArtPackStages(t *testing.T) {
+	classic, err := game.FindArtPack(game.DefaultArtPack)
+	if err != nil {
+		t.Fatalf("FindArtPack failed: %v", err)
+	}
+	if len(classic.Stages) != 7 {
+		t.Fatalf("Expected 7 classic stages, got %d", len(classic.Stages))
+	}
+	for wrong := 0; wrong <= 6; wrong++ {
+		if classic.Stage(wrong, 6) != classic.Stages[wrong] {
+			t.Errorf("Expected one classic stage per wrong guess, stage %d differs", wrong)
+		}
+	}
+
+	// Eleven stages over six guesses, or seven over ten: the ends only at the
+	// ends, and never going backwards
+	long := &game.ArtPack{Name: "long", Stages: strings.Split("0 1 2 3 4 5 6 7 8 9 10", " ")}
+	for _, tc := range []struct {
+		pack     *game.ArtPack
+		maxWrong int
+	}{{long, 6}, {classic, 10}, {long, 1}} {
+		last := len(tc.pack.Stages) - 1
+		previous := ""
+		for wrong := 0; wrong <= tc.maxWrong; wrong++ {
+			stage := tc.pack.Stage(wrong, tc.maxWrong)
+			isFirst, isLast := stage == tc.pack.Stages[0], stage == tc.pack.Stages[last]
+			if isFirst != (wrong == 0) || isLast != (wrong == tc.maxWrong) {
+				t.Errorf("%s over %d: unexpected stage for %d wrong guesses", tc.pack.Name, tc.maxWrong, wrong)
+			}
+			if stage == previous && wrong > 0 && tc.maxWrong < last {
+				t.Errorf("%s over %d: stage repeated at %d wrong guesses", tc.pack.Name, tc.maxWrong, wrong)
+			}
+			previous = stage
+		}
+	}
+}
+
+func TestPlayerArtPacks(t *testing.T) {
+	home := setDataEnv(t)
+	artDir := filepath.Join(home, ".hangman", game.ArtDir)
+	if err := os.MkdirAll(artDir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(artDir, "dots.txt"), []byte(".\n---\n..\n---\n..."), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(artDir, "broken.txt"), []byte("one stage"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	packs, errs := game.ArtPacks()
+	if len(errs) != 1 {
+		t.Errorf("Expected the broken pack to be reported, got %v", errs)
+	}
+	var names []string
+	for _, pack := range packs {
+		names = append(names, pack.Name)
+	}
+	if strings.Join(names, ",") != "classic,dots,gallows,minimal" {
+		t.Errorf("Expected the built-in packs and dots, got %v", names)
+	}
+
+	t.Cleanup(func() {
+		_ = game.UseArtPack(game.DefaultArtPack) //nolint:errcheck // The default pack is embedded
+	})
+	if err := game.UseArtPack("dots"); err != nil {
+		t.Fatalf("UseArtPack failed: %v", err)
+	}
+	if game.ActiveArtPack().Stage(6, 6) != "..." {
+		t.Errorf("Expected the player's pack to be drawn, got %q", game.ActiveArtPack().Stage(6, 6))
+	}
+	if err := game.UseArtPack("missing"); !errors.Is(err, game.ErrUnknownArtPack) {
+		t.Errorf("Expected ErrUnknownArtPack, got %v", err)
+	}
+}
+
+func TestThemes(t *testing.T) {
+	t.Cleanup(func() {
+		_ = utils.SetTheme(utils.DefaultTheme) //nolint:errcheck // The default theme is built in
+	})
+
+	if err := utils.SetTheme("nope"); !errors.Is(err, utils.ErrUnknownTheme) {
+		t.Errorf("Expected ErrUnknownTheme, got %v", err)
+	}
+	if err := utils.SetTheme("Mono"); err != nil {
+		t.Fatalf("SetTheme failed: %v", err)
+	}
+	if utils.CurrentTheme().Name != "mono" || utils.CurrentTheme().Yellow != (utils.Color{}) {
+		t.Errorf("Expected the mono theme without colors, got %+v", utils.CurrentTheme())
+	}
+	if err := utils.SetTheme(""); err != nil || utils.CurrentTheme().Name != utils.DefaultTheme {
+		t.Errorf("Expected an empty name to select the default theme, got %v", err)
+	}
+}
+
+//nolint:staticcheck // SA1019: Outside importers still use the deprecated stages
+func TestDeprecatedHangmanStages(t *testing.T) {
+	gameOver := `
+   +---+
+   |   |
+   O   |
+  /|\  |
+  / \  |
+       |
+=========`
+	if assets.GetMaxStages() != 6 || assets.GetHangmanStage(6) != gameOver {
+		t.Errorf("Expected the classic stages as before, got %d stages ending in %q",
+			assets.GetMaxStages(), assets.GetHangmanStage(assets.GetMaxStages()))
+	}
+	if assets.GetHangmanStage(-1) != assets.HangmanStages[0] || !strings.HasPrefix(assets.HangmanStages[0], "\n   +---+") {
+		t.Errorf("Expected the empty gallows for an invalid stage, got %q", assets.GetHangmanStage(-1))
+	}
+}
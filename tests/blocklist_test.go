@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/VinayBhutange/hangman-go/game"
+)
+
+func TestBlocklistContains(t *testing.T) {
+	b := game.NewBlocklist("badword", " Rude ")
+
+	for _, word := range []string{"BADWORD", "badword", "BADWORDS", "RUDE", "BADWORDING"} {
+		if !b.Contains(word) {
+			t.Errorf("Expected %s to be blocked", word)
+		}
+	}
+
+	for _, word := range []string{"PUZZLE", "PRUDE", "RUDIMENT"} {
+		if b.Contains(word) {
+			t.Errorf("Did not expect %s to be blocked", word)
+		}
+	}
+
+	var nilList *game.Blocklist
+	if nilList.Contains("BADWORD") {
+		t.Error("A nil blocklist should block nothing")
+	}
+}
+
+func TestDefaultBlocklist(t *testing.T) {
+	b := game.DefaultBlocklist()
+	if b.Len() == 0 {
+		t.Fatal("Expected built-in blocklist to contain words")
+	}
+
+	// The shipped word pack must pass the built-in filter untouched
+	wordList := game.GetDefaultWords()
+	if _, blocked := b.Filter(wordList.Words); len(blocked) != 0 {
+		t.Errorf("Default words should not be blocked: %v", blocked)
+	}
+}
+
+func TestLoadBlocklist(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "blocklist.txt")
+	if err := os.WriteFile(filename, []byte("# comment\nbadword\n\nrude\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := game.LoadBlocklist(filename)
+	if err != nil {
+		t.Fatalf("LoadBlocklist failed: %v", err)
+	}
+
+	if b.Len() != 2 {
+		t.Errorf("Expected 2 blocked words, got %v", b.Words())
+	}
+}
+
+func TestWordListBlocklist(t *testing.T) {
+	wordList := &game.WordList{Words: []string{"PUZZLE", "BADWORD", testWordGolang}}
+
+	filtered := wordList.SetBlocklist(game.NewBlocklist("badword"))
+	if len(filtered) != 1 || wordList.GetWordCount() != 2 {
+		t.Errorf("Expected BADWORD to be filtered, got %v", wordList.Words)
+	}
+
+	wordList.AddWord("badwords")
+	if wordList.GetWordCount() != 2 {
+		t.Error("Blocked word should not be added")
+	}
+}
+
+func TestWordListBlocklistWithSources(t *testing.T) {
+	source := &game.WordSource{Name: "pack", Words: []string{"PUZZLE", "BADWORD"}, Enabled: true}
+	wordList := game.MergeWordSources([]*game.WordSource{source})
+
+	wordList.SetBlocklist(game.NewBlocklist("badword"))
+	if wordList.GetWordCount() != 1 {
+		t.Errorf("Expected BADWORD to be filtered, got %v", wordList.Words)
+	}
+
+	// Lifting the filter restores words that are still in a source
+	wordList.SetBlocklist(nil)
+	if wordList.GetWordCount() != 2 {
+		t.Errorf("Expected BADWORD to be restored, got %v", wordList.Words)
+	}
+}
@@ -0,0 +1,60 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/VinayBhutange/hangman-go/game"
+)
+
+func TestRecordGameBreakdowns(t *testing.T) {
+	stats := game.NewStatistics()
+	stats.RecordGame(playWonGame(), game.DifficultyHard)
+	stats.RecordGame(playLostGame(), game.DifficultyHard)
+	stats.RecordGame(playWonGame(), game.DifficultyHard)
+	stats.RecordGame(playWonGame(), game.DifficultyEasy)
+
+	hard := stats.DifficultyStats(game.DifficultyHard)
+	if hard.Played != 3 || hard.Won != 2 || hard.Lost != 1 || hard.CurrentStreak != 1 || hard.LongestStreak != 1 {
+		t.Errorf("Unexpected hard results: %+v", hard)
+	}
+	if hard.BestGame != 1 || hard.AverageWrongGuesses() != 8.0/3 {
+		t.Errorf("Expected best game 1 and 8 wrong guesses over 3 games, got %+v", hard)
+	}
+
+	easy := stats.DifficultyStats(game.DifficultyEasy)
+	if easy.Played != 1 || easy.WinRate() != 100 {
+		t.Errorf("Unexpected easy results: %+v", easy)
+	}
+
+	// Won games come from the "animals" source, the lost one has none
+	if animals := stats.CategoryStats("animals"); animals.Played != 3 || animals.CurrentStreak != 3 {
+		t.Errorf("Unexpected animals results: %+v", animals)
+	}
+	if none := stats.DifficultyStats(game.DifficultyMedium); none.Played != 0 || none.WinRate() != 0 {
+		t.Errorf("Expected no medium results, got %+v", none)
+	}
+}
+
+func TestMigrationRebuildsBreakdownsFromHistory(t *testing.T) {
+	writeStatsFile(t, `{"version": 2, "games_played": 2, "games_won": 1, "games_lost": 1, "difficulties": {"easy": 2}, "sources": {}, "words_guessed": []}`)
+
+	history := []game.HistoryEntry{
+		game.NewHistoryEntry(playLostGame(), game.DifficultyEasy),
+		game.NewHistoryEntry(playWonGame(), game.DifficultyEasy),
+	}
+	if err := game.AppendHistory(history...); err != nil {
+		t.Fatalf("AppendHistory failed: %v", err)
+	}
+
+	stats, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+	easy := stats.DifficultyStats(game.DifficultyEasy)
+	if easy.Played != 2 || easy.Won != 1 || easy.CurrentStreak != 1 || easy.WrongGuesses != 7 {
+		t.Errorf("Expected easy results rebuilt from the history, got %+v", easy)
+	}
+	if stats.CategoryStats("animals").Won != 1 {
+		t.Errorf("Expected category results rebuilt from the history, got %+v", stats.ByCategory)
+	}
+}
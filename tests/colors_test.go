@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/VinayBhutange/hangman-go/game"
+	"github.com/VinayBhutange/hangman-go/utils"
+)
+
+// env returns a getenv function over a fixed set of variables
+func env(vars map[string]string) func(string) string {
+	return func(key string) string { return vars[key] }
+}
+
+func TestParseColorMode(t *testing.T) {
+	for _, value := range []string{"auto", "always", "never", "Never"} {
+		mode, err := utils.ParseColorMode(value)
+		if err != nil || string(mode) != strings.ToLower(value) {
+			t.Errorf("ParseColorMode(%q) = %q, %v", value, mode, err)
+		}
+	}
+	if _, err := utils.ParseColorMode("sometimes"); !errors.Is(err, utils.ErrUnknownColorMode) {
+		t.Errorf("Expected ErrUnknownColorMode, got %v", err)
+	}
+}
+
+func TestDetectColorLevel(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Auto detection needs Windows Terminal on Windows")
+	}
+
+	tests := []struct {
+		name string
+		mode utils.ColorMode
+		vars map[string]string
+		tty  bool
+		want utils.ColorLevel
+	}{
+		{"terminal", utils.ColorAuto, map[string]string{"TERM": "xterm"}, true, utils.Color16},
+		{"pipe", utils.ColorAuto, map[string]string{"TERM": "xterm"}, false, utils.ColorNone},
+		{"dumb terminal", utils.ColorAuto, map[string]string{"TERM": "dumb"}, true, utils.ColorNone},
+		{"no TERM", utils.ColorAuto, nil, true, utils.ColorNone},
+		{"256 colors", utils.ColorAuto, map[string]string{"TERM": "xterm-256color"}, true, utils.Color256},
+		{"truecolor", utils.ColorAuto, map[string]string{"TERM": "xterm-256color", "COLORTERM": "truecolor"}, true, utils.ColorTrue},
+		{"NO_COLOR", utils.ColorAuto, map[string]string{"TERM": "xterm", "NO_COLOR": "1"}, true, utils.ColorNone},
+		{"FORCE_COLOR into a pipe", utils.ColorAuto, map[string]string{"FORCE_COLOR": "1"}, false, utils.Color16},
+		{"FORCE_COLOR=3", utils.ColorAuto, map[string]string{"FORCE_COLOR": "3"}, false, utils.ColorTrue},
+		{"FORCE_COLOR=0", utils.ColorAuto, map[string]string{"TERM": "xterm", "FORCE_COLOR": "0"}, true, utils.ColorNone},
+		{"NO_COLOR wins over FORCE_COLOR", utils.ColorAuto, map[string]string{"NO_COLOR": "1", "FORCE_COLOR": "1"}, true, utils.ColorNone},
+		{"always into a pipe", utils.ColorAlways, map[string]string{"NO_COLOR": "1"}, false, utils.Color16},
+		{"always keeps the palette", utils.ColorAlways, map[string]string{"TERM": "xterm-256color"}, false, utils.Color256},
+		{"never", utils.ColorNever, map[string]string{"TERM": "xterm", "FORCE_COLOR": "1"}, true, utils.ColorNone},
+	}
+
+	for _, tt := range tests {
+		if got := utils.DetectColorLevel(tt.mode, env(tt.vars), tt.tty); got != tt.want {
+			t.Errorf("%s: expected level %d, got %d", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestColorCodes(t *testing.T) {
+	t.Cleanup(func() {
+		_ = utils.SetTheme(utils.DefaultTheme) //nolint:errcheck // The default theme is built in
+	})
+
+	theme, err := utils.FindTheme("colorblind")
+	if err != nil {
+		t.Fatalf("FindTheme failed: %v", err)
+	}
+	orange := theme.Red
+	levels := map[utils.ColorLevel]string{
+		utils.ColorNone: "",
+		utils.Color16:   utils.ColorYellow,
+		utils.Color256:  "\033[38;5;208m",
+		utils.ColorTrue: "\033[38;2;230;159;0m",
+	}
+	for level, want := range levels {
+		if got := orange.Code(level); got != want {
+			t.Errorf("Level %d: expected code %q, got %q", level, want, got)
+		}
+	}
+
+	// Colors without richer codes fall back to the basic one
+	classic, _ := utils.FindTheme(utils.DefaultTheme) //nolint:errcheck // The default theme is built in
+	if got := classic.Red.Code(utils.ColorTrue); got != utils.ColorRed {
+		t.Errorf("Expected the basic red at every level, got %q", got)
+	}
+
+	// Renderers draw at the level they are given
+	if err := utils.SetTheme("colorblind"); err != nil {
+		t.Fatalf("SetTheme failed: %v", err)
+	}
+	var out bytes.Buffer
+	game.NewRenderer(&out, 0, utils.ColorTrue).GameState(midGame())
+	if !strings.Contains(out.String(), "\033[38;2;") {
+		t.Errorf("Expected 24-bit color codes, got %q", out.String())
+	}
+	out.Reset()
+	game.NewRenderer(&out, 0, utils.ColorNone).GameState(midGame())
+	if strings.Contains(out.String(), "\033[") {
+		t.Errorf("Expected plain text, got %q", out.String())
+	}
+}
+
+func TestColorModeNever(t *testing.T) {
+	t.Cleanup(func() { utils.SetColorMode(utils.ColorAuto) })
+
+	utils.SetColorMode(utils.ColorNever)
+	if utils.ColorSupported() || utils.Red("miss") != "miss" {
+		t.Errorf("Expected no colors with --color=never, got %q", utils.Red("miss"))
+	}
+	utils.SetColorMode(utils.ColorAlways)
+	if !utils.ColorSupported() || !strings.Contains(utils.Red("miss"), "\033[") {
+		t.Errorf("Expected colors with --color=always, got %q", utils.Red("miss"))
+	}
+}
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/VinayBhutange/hangman-go/game"
+)
+
+// containsWord checks whether a word list contains a word
+func containsWord(words []string, word string) bool {
+	for _, w := range words {
+		if w == word {
+			return true
+		}
+	}
+	return false
+}
+
+func TestImportHunspellStripsFlags(t *testing.T) {
+	opts := game.ImportOptions{MinLength: 3}
+	words, err := game.ImportHunspell("testdata/en_small.dic", "", opts)
+	if err != nil {
+		t.Fatalf("ImportHunspell failed: %v", err)
+	}
+
+	expected := []string{"CAT", "TRY", "HAPPY", "SKY"}
+	if len(words) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, words)
+	}
+	for _, word := range expected {
+		if !containsWord(words, word) {
+			t.Errorf("Expected %s in imported words %v", word, words)
+		}
+	}
+}
+
+func TestImportHunspellExpandsAffixes(t *testing.T) {
+	opts := game.ImportOptions{ExpandAffixes: true, MinLength: 3}
+	words, err := game.ImportHunspell("testdata/en_small.dic", "testdata/en_small.aff", opts)
+	if err != nil {
+		t.Fatalf("ImportHunspell failed: %v", err)
+	}
+
+	for _, word := range []string{"CATS", "RECAT", "TRIED", "UNHAPPY", "SKIES"} {
+		if !containsWord(words, word) {
+			t.Errorf("Expected expanded form %s in %v", word, words)
+		}
+	}
+
+	for _, word := range []string{"TRYED", "SKYS", "X-RAYS"} {
+		if containsWord(words, word) {
+			t.Errorf("Did not expect %s in %v", word, words)
+		}
+	}
+}
+
+func TestImportHunspellRequiresAffForExpansion(t *testing.T) {
+	opts := game.ImportOptions{ExpandAffixes: true}
+	if _, err := game.ImportHunspell("testdata/en_small.dic", "", opts); err == nil {
+		t.Error("Expected error when expanding without an .aff file")
+	}
+}
+
+func TestImportAspell(t *testing.T) {
+	words, err := game.ImportAspell("testdata/aspell_small.txt", game.DefaultImportOptions())
+	if err != nil {
+		t.Fatalf("ImportAspell failed: %v", err)
+	}
+
+	expected := []string{"APPLE", "BANANA", "KIWI"}
+	if len(words) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, words)
+	}
+	for i, word := range expected {
+		if words[i] != word {
+			t.Errorf("Expected %s at %d, got %s", word, i, words[i])
+		}
+	}
+}
+
+func TestImportHunspellEncodingAndCrossProducts(t *testing.T) {
+	german, ok := game.GetLanguage("de")
+	if !ok {
+		t.Fatal("German is not available")
+	}
+	opts := game.ImportOptions{ExpandAffixes: true, MinLength: 3, Language: german}
+	words, err := game.ImportHunspell("testdata/de_latin1.dic", "testdata/de_latin1.aff", opts)
+	if err != nil {
+		t.Fatalf("ImportHunspell failed: %v", err)
+	}
+
+	// Umlauts are decoded from ISO-8859-1 instead of being dropped
+	for _, word := range []string{"KÄSE", "KÄSEN", "MÜDE", "VERKÄSE", "VERFÜHREN"} {
+		if !containsWord(words, word) {
+			t.Errorf("Expected %s in %v", word, words)
+		}
+	}
+	// The N suffix doesn't allow cross products, so it doesn't take the prefix
+	if containsWord(words, "VERKÄSEN") {
+		t.Errorf("Did not expect VERKÄSEN in %v", words)
+	}
+
+	if _, err := game.ImportHunspell("testdata/de_latin1.dic", "testdata/koi8.aff", opts); err == nil {
+		t.Error("Expected an error for an unsupported encoding")
+	}
+}
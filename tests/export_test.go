@@ -0,0 +1,199 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VinayBhutange/hangman-go/game"
+)
+
+// playLostGame returns a finished game lost without a correct guess
+func playLostGame() *game.Game {
+	g := game.NewGameWithSeed([]string{testWordGo}, 7)
+	for _, letter := range "ABCDEF" {
+		g.GuessLetter(letter)
+	}
+	return g
+}
+
+func TestExportCSV(t *testing.T) {
+	stats := game.NewStatistics()
+	g := playWonGame()
+	stats.RecordGame(g, game.DifficultyEasy)
+
+	var out bytes.Buffer
+	if err := game.ExportStatistics(&out, game.FormatCSV, stats, nil); err != nil {
+		t.Fatalf("ExportStatistics failed: %v", err)
+	}
+	for _, row := range []string{"metric,value", "games_won,1", "win_rate,100.0", "difficulty:easy,1", "source:animals,1"} {
+		if !strings.Contains(out.String(), row+"\n") {
+			t.Errorf("Expected row %q in:\n%s", row, out.String())
+		}
+	}
+
+	out.Reset()
+	history := []game.HistoryEntry{game.NewHistoryEntry(g, game.DifficultyEasy)}
+	if err := game.ExportHistory(&out, game.FormatCSV, history); err != nil {
+		t.Fatalf("ExportHistory failed: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "played_at,word,") || !strings.Contains(lines[1], ",GO,easy,animals,XGO,1,") {
+		t.Errorf("Unexpected history CSV:\n%s", out.String())
+	}
+
+	if err := game.ExportHistory(&out, "xml", history); err == nil {
+		t.Error("Expected an error for an unknown format")
+	}
+}
+
+func TestImportMergesAndDeduplicates(t *testing.T) {
+	setDataEnv(t)
+
+	// Another machine won twice, then lost
+	var otherHistory []game.HistoryEntry
+	other := game.NewStatistics()
+	start := time.Now().Add(-time.Hour)
+	for i, g := range []*game.Game{playWonGame(), playWonGame(), playLostGame()} {
+		entry := game.NewHistoryEntry(g, game.DifficultyMedium)
+		entry.PlayedAt = start.Add(time.Duration(i) * time.Minute)
+		otherHistory = append(otherHistory, entry)
+	}
+	other.GamesPlayed, other.GamesWon, other.GamesLost, other.LongestStreak = 3, 2, 1, 2
+	other.Difficulties[game.DifficultyMedium] = 3
+
+	var export bytes.Buffer
+	if err := game.ExportStatistics(&export, game.FormatJSON, other, otherHistory); err != nil {
+		t.Fatalf("ExportStatistics failed: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "export.json")
+	if err := os.WriteFile(path, export.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// This machine has one later win
+	stats := game.NewStatistics()
+	stats.RecordGame(playWonGame(), game.DifficultyEasy)
+	if err := stats.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+
+	for attempt := 0; attempt < 2; attempt++ {
+		imported, history, err := game.ReadImport(path)
+		if err != nil {
+			t.Fatalf("ReadImport failed: %v", err)
+		}
+		result, err := stats.Import(imported, history)
+		if err != nil {
+			t.Fatalf("Import failed: %v", err)
+		}
+		if err := stats.SaveStatistics(); err != nil {
+			t.Fatalf("SaveStatistics failed: %v", err)
+		}
+
+		// Importing the same file again adds nothing
+		wantGames, wantDuplicates := 3, 0
+		if attempt == 1 {
+			wantGames, wantDuplicates = 0, 3
+		}
+		if result.Games != wantGames || result.Duplicates != wantDuplicates {
+			t.Errorf("Import %d: expected %d games and %d duplicates, got %+v", attempt+1, wantGames, wantDuplicates, result)
+		}
+	}
+
+	loaded, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+	if loaded.GamesPlayed != 4 || loaded.GamesWon != 3 || loaded.Difficulties[game.DifficultyMedium] != 3 {
+		t.Errorf("Expected the counts to be summed once, got %+v", loaded)
+	}
+	// Win, win, loss, then this machine's win
+	if loaded.CurrentStreak != 1 || loaded.LongestStreak != 2 {
+		t.Errorf("Expected streaks 1 and 2 from the combined history, got %d and %d", loaded.CurrentStreak, loaded.LongestStreak)
+	}
+
+	history, err := game.LoadHistory()
+	if err != nil || len(history) != 4 {
+		t.Errorf("Expected 4 games in the history, got %d (%v)", len(history), err)
+	}
+}
+
+func TestImportKeepsGamesSavedMeanwhile(t *testing.T) {
+	setDataEnv(t)
+
+	other := game.NewStatistics()
+	entry := game.NewHistoryEntry(playLostGame(), game.DifficultyHard)
+	entry.PlayedAt = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	other.RecordGame(playLostGame(), game.DifficultyHard)
+
+	stats, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+	if _, err := stats.Import(other, []game.HistoryEntry{entry}); err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	// Another window saves a game before the import is saved
+	window, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+	window.RecordGame(playWonGame(), game.DifficultyEasy)
+	if err := window.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+
+	if err := stats.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+	loaded, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+	if loaded.GamesPlayed != 2 || loaded.GamesWon != 1 || loaded.GamesLost != 1 {
+		t.Errorf("Expected the other window's game and the imported one, got %+v", loaded)
+	}
+	if history, err := game.LoadHistory(); err != nil || len(history) != 2 {
+		t.Errorf("Expected 2 games in the history, got %d (%v)", len(history), err)
+	}
+}
+
+func TestImportWithoutHistoryIsUntracked(t *testing.T) {
+	useMemoryStore(t)
+
+	other := game.NewStatistics()
+	other.GamesPlayed, other.GamesWon = 2, 2
+
+	stats := game.NewStatistics()
+	result, err := stats.Import(other, nil)
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if result.Games != 2 || result.Untracked != 2 {
+		t.Errorf("Expected 2 untracked games, got %+v", result)
+	}
+}
+
+func TestReadImportRejectsImpossibleGames(t *testing.T) {
+	entry := game.NewHistoryEntry(playWonGame(), game.DifficultyMedium)
+	entry.WrongGuesses = -1
+
+	var export bytes.Buffer
+	if err := game.ExportStatistics(&export, game.FormatJSON, game.NewStatistics(), []game.HistoryEntry{entry}); err != nil {
+		t.Fatalf("ExportStatistics failed: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "export.json")
+	if err := os.WriteFile(path, export.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := game.ReadImport(path); !errors.Is(err, game.ErrInvalidEntry) {
+		t.Errorf("Expected ErrInvalidEntry, got %v", err)
+	}
+}
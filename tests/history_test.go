@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/VinayBhutange/hangman-go/game"
+)
+
+// playWonGame returns a finished game won with one wrong guess
+func playWonGame() *game.Game {
+	g := game.NewGameWithSeed([]string{testWordGo}, 42)
+	g.Source = "animals"
+	g.GuessLetter('X')
+	g.GuessLetter('G')
+	g.GuessLetter('O')
+	return g
+}
+
+func TestNewGameWithSeed(t *testing.T) {
+	words := []string{"TIGER", "ZEBRA", "PANDA", "GIRAFFE", "OTTER"}
+	first := game.NewGameWithSeed(words, 7)
+	second := game.NewGameWithSeed(words, 7)
+
+	if first.Word != second.Word {
+		t.Errorf("Expected the same seed to pick the same word, got %s and %s", first.Word, second.Word)
+	}
+	if first.Seed != 7 {
+		t.Errorf("Expected seed 7 to be recorded, got %d", first.Seed)
+	}
+}
+
+func TestSaveStatisticsAppendsHistory(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	stats := game.NewStatistics()
+	stats.RecordGame(playWonGame(), game.DifficultyEasy)
+	if err := stats.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+
+	// Saving again must not log the same game twice
+	if err := stats.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+
+	entries, err := game.LoadHistory()
+	if err != nil {
+		t.Fatalf("LoadHistory failed: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 history entry, got %d", len(entries))
+	}
+
+	entry := entries[0]
+	if entry.Word != testWordGo || entry.Guesses != "XGO" || entry.WrongGuesses != 1 {
+		t.Errorf("Unexpected entry: %+v", entry)
+	}
+	if !entry.Won() || entry.Category != "animals" || entry.Difficulty != game.DifficultyEasy || entry.Seed != 42 {
+		t.Errorf("Unexpected entry: %+v", entry)
+	}
+}
+
+func TestLoadHistorySkipsBrokenLines(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	if err := game.AppendHistory(game.NewHistoryEntry(playWonGame(), game.DifficultyEasy)); err != nil {
+		t.Fatalf("AppendHistory failed: %v", err)
+	}
+
+	// Simulate a crash in the middle of writing a line
+	path := filepath.Join(home, ".hangman", game.HistoryFile)
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.WriteString(`{"word":"TIG`); err != nil {
+		t.Fatal(err)
+	}
+	_ = file.Close() //nolint:errcheck // Test file
+
+	entries, err := game.LoadHistory()
+	if err != nil {
+		t.Fatalf("LoadHistory failed: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("Expected the broken line to be skipped, got %d entries", len(entries))
+	}
+}
+
+func TestPruneHistory(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var entries []game.HistoryEntry
+	for i := 0; i < 5; i++ {
+		entry := game.NewHistoryEntry(playWonGame(), game.DifficultyEasy)
+		entry.PlayedAt = time.Now().AddDate(0, 0, -10+i*2) // 10, 8, 6, 4 and 2 days ago
+		entries = append(entries, entry)
+	}
+	if err := game.AppendHistory(entries...); err != nil {
+		t.Fatalf("AppendHistory failed: %v", err)
+	}
+
+	removed, err := game.PruneHistory(0, 7)
+	if err != nil || removed != 2 {
+		t.Fatalf("Expected 2 games older than a week removed, got %d (%v)", removed, err)
+	}
+
+	removed, err = game.PruneHistory(2, 0)
+	if err != nil || removed != 1 {
+		t.Fatalf("Expected 1 game removed to keep 2, got %d (%v)", removed, err)
+	}
+
+	kept, _ := game.LoadHistory() //nolint:errcheck // Checked by the count
+	if len(kept) != 2 || !kept[1].PlayedAt.After(kept[0].PlayedAt) {
+		t.Errorf("Expected the 2 newest games in order, got %+v", kept)
+	}
+}
@@ -0,0 +1,146 @@
+package tests
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/VinayBhutange/hangman-go/i18n"
+	"github.com/VinayBhutange/hangman-go/utils"
+)
+
+// formatVerb matches a fmt verb such as %d, %5d or %.1f
+var formatVerb = regexp.MustCompile(`%[-+# 0]*[0-9]*(\.[0-9]+)?[a-zA-Z%]`)
+
+// verbs returns the format verbs of a message with widths removed
+func verbs(message string) []string {
+	var result []string
+	for _, verb := range formatVerb.FindAllString(message, -1) {
+		result = append(result, verb[len(verb)-1:])
+	}
+	return result
+}
+
+func TestEveryKeyInEveryLocale(t *testing.T) {
+	english, err := i18n.LoadCatalog(i18n.DefaultLocale)
+	if err != nil {
+		t.Fatalf("Failed to load %s: %v", i18n.DefaultLocale, err)
+	}
+
+	locales := i18n.Locales()
+	if len(locales) < 2 {
+		t.Fatalf("Expected several shipped locales, got %v", locales)
+	}
+
+	for _, locale := range locales {
+		catalog, err := i18n.LoadCatalog(locale)
+		if err != nil {
+			t.Fatalf("Failed to load %s: %v", locale, err)
+		}
+
+		for _, key := range english.Keys() {
+			msg, ok := catalog.Messages[key]
+			if !ok {
+				t.Errorf("%s: missing key %s", locale, key)
+				continue
+			}
+
+			want := verbs(english.Messages[key].Other)
+			for _, form := range []string{msg.One, msg.Other} {
+				if got := verbs(form); !reflect.DeepEqual(got, want) {
+					t.Errorf("%s: %s uses verbs %v, expected %v", locale, key, got, want)
+				}
+			}
+		}
+
+		for _, key := range catalog.Keys() {
+			if _, ok := english.Messages[key]; !ok {
+				t.Errorf("%s: key %s is not in %s", locale, key, i18n.DefaultLocale)
+			}
+		}
+	}
+}
+
+func TestPluralization(t *testing.T) {
+	defer func() {
+		_ = i18n.SetLocale(i18n.DefaultLocale) //nolint:errcheck // Default locale is embedded
+	}()
+
+	if err := i18n.SetLocale("en"); err != nil {
+		t.Fatal(err)
+	}
+	if got := i18n.N("game.word_length", 1); got != "The word has 1 letter." {
+		t.Errorf("Unexpected singular: %q", got)
+	}
+	if got := i18n.N("game.word_length", 7); got != "The word has 7 letters." {
+		t.Errorf("Unexpected plural: %q", got)
+	}
+
+	if err := i18n.SetLocale("es"); err != nil {
+		t.Fatal(err)
+	}
+	if got := i18n.N("game.word_length", 5); got != "La palabra tiene 5 letras." {
+		t.Errorf("Unexpected Spanish plural: %q", got)
+	}
+}
+
+func TestTranslationFallback(t *testing.T) {
+	if got := i18n.T("no.such.key"); got != "no.such.key" {
+		t.Errorf("Expected unknown keys to be returned as is, got %q", got)
+	}
+
+	if err := i18n.SetLocale("xx"); err == nil {
+		t.Error("Expected an error for an unknown locale")
+	}
+	if i18n.Locale() != i18n.DefaultLocale {
+		t.Errorf("Expected the locale to stay %s, got %s", i18n.DefaultLocale, i18n.Locale())
+	}
+}
+
+func TestDetectLocale(t *testing.T) {
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_MESSAGES", "")
+	t.Setenv("LANG", "de_DE.UTF-8")
+
+	if got := i18n.DetectLocale(); got != "de" {
+		t.Errorf("Expected de from LANG, got %s", got)
+	}
+	if got := i18n.DetectLocale("es"); got != "es" {
+		t.Errorf("Expected an explicit preference to win, got %s", got)
+	}
+	if got := i18n.DetectLocale("", "fr"); got != "de" {
+		t.Errorf("Expected unsupported preferences to be skipped, got %s", got)
+	}
+
+	t.Setenv("LANG", "C")
+	if got := i18n.DetectLocale(); got != i18n.DefaultLocale {
+		t.Errorf("Expected %s without a supported locale, got %s", i18n.DefaultLocale, got)
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	defer i18n.UsePlainText(false)
+
+	tests := map[string]string{
+		"🎮 MAIN MENU":            "MAIN MENU",
+		"🎉 CONGRATULATIONS! 🎉":   "CONGRATULATIONS!",
+		"⚠️  Careful":            "Careful",
+		"ℹ️ Info":                "Info",
+		"Grüße, ¿qué tal? 1 · 2": "Grüße, ¿qué tal? 1 · 2",
+		"Enter your choice: ":    "Enter your choice: ",
+	}
+	for text, want := range tests {
+		if got := i18n.StripSymbols(text); got != want {
+			t.Errorf("StripSymbols(%q) = %q, want %q", text, got, want)
+		}
+	}
+
+	i18n.UsePlainText(true)
+	if got := i18n.T("menu.main.title"); strings.Contains(got, "🎮") || got == "" {
+		t.Errorf("Expected the menu title without emoji, got %q", got)
+	}
+	if got := utils.Success("Saved"); got != utils.Green("Saved") {
+		t.Errorf("Expected no emoji before messages, got %q", got)
+	}
+}
@@ -0,0 +1,216 @@
+package tests
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/VinayBhutange/hangman-go/game"
+)
+
+func TestIndexFollowsAddAndRemove(t *testing.T) {
+	wordList := &game.WordList{Words: []string{"TIGER", "ZEBRA", "TIGER", "PANDA", "GIRAFFE"}}
+
+	if got := wordList.GetWordsByLength(5, 5); len(got) != 4 {
+		t.Errorf("Expected 4 five-letter words, got %v", got)
+	}
+
+	wordList.RemoveWord("tiger")
+	wordList.AddWord("lion")
+
+	got := wordList.GetWordsByLength(4, 5)
+	sort.Strings(got)
+	if strings.Join(got, ",") != "LION,PANDA,ZEBRA" {
+		t.Errorf("Expected LION, PANDA and ZEBRA, got %v", got)
+	}
+	if wordList.GetWordCount() != 4 {
+		t.Errorf("Expected 4 words, got %v", wordList.Words)
+	}
+}
+
+func TestIndexFollowsReplacedWords(t *testing.T) {
+	wordList := &game.WordList{Words: []string{"TIGER", "ZEBRA"}}
+	if got := wordList.GetWordsByLength(5, 5); len(got) != 2 {
+		t.Fatalf("Expected 2 five-letter words, got %v", got)
+	}
+
+	// A new list of the same length must not be answered from the old index
+	wordList.Words = []string{"LION", "BEAR"}
+	got := wordList.GetWordsByLength(4, 4)
+	sort.Strings(got)
+	if strings.Join(got, ",") != "BEAR,LION" {
+		t.Errorf("Expected BEAR and LION, got %v", got)
+	}
+}
+
+func TestRemoveWordKeepsOrder(t *testing.T) {
+	wordList := &game.WordList{Words: []string{"APPLE", "TIGER", "MANGO", "ZEBRA", "PANDA", "LEMON"}}
+	wordList.GetWordsByLength(5, 5) // Build the index first
+
+	wordList.RemoveWord("tiger")
+	wordList.RemoveWord("APPLE")
+
+	want := "MANGO,ZEBRA,PANDA,LEMON"
+	if got := strings.Join(wordList.Words, ","); got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+	if got := strings.Join(wordList.GetWordsByLength(5, 5), ","); got != want {
+		t.Errorf("Expected %s by length, got %s", want, got)
+	}
+}
+
+func TestGetWordsByCategory(t *testing.T) {
+	animals := &game.WordSource{Name: "animals", Words: []string{"TIGER", "ZEBRA"}, Enabled: true}
+	fruits := &game.WordSource{Name: "fruits", Words: []string{"APPLE", "ZEBRA"}, Enabled: true}
+	wordList := game.MergeWordSources([]*game.WordSource{animals, fruits})
+
+	if got := wordList.GetWordsByCategory("fruits"); len(got) != 1 || got[0] != "APPLE" {
+		t.Errorf("Expected only APPLE in fruits, got %v", got)
+	}
+
+	wordList.AddWord("puzzle")
+	if got := wordList.GetWordsByCategory(game.CustomSource); len(got) != 1 || got[0] != "PUZZLE" {
+		t.Errorf("Expected PUZZLE in the custom source, got %v", got)
+	}
+
+	wordList.RemoveWord("zebra")
+	if got := wordList.GetWordsByCategory("animals"); len(got) != 1 || got[0] != "TIGER" {
+		t.Errorf("Expected only TIGER left in animals, got %v", got)
+	}
+}
+
+func TestGetWordsWithLetters(t *testing.T) {
+	wordList := &game.WordList{Words: []string{"TIGER", "ZEBRA", "PANDA", "GIRAFFE"}}
+
+	got := wordList.GetWordsWithLetters(0, "r", "z")
+	sort.Strings(got)
+	if strings.Join(got, ",") != "GIRAFFE,TIGER" {
+		t.Errorf("Expected GIRAFFE and TIGER, got %v", got)
+	}
+
+	if got := wordList.GetWordsWithLetters(5, "a", ""); len(got) != 2 {
+		t.Errorf("Expected ZEBRA and PANDA, got %v", got)
+	}
+
+	if got := wordList.GetWordsWithLetters(0, "q", ""); len(got) != 0 {
+		t.Errorf("Expected no words with Q, got %v", got)
+	}
+}
+
+func TestIndexMatchesScan(t *testing.T) {
+	rng := rand.New(rand.NewSource(2)) //nolint:gosec // G404: Deterministic test data
+	pool := []string{"TIGER", "ZEBRA", "PANDA", "GIRAFFE", "LION", "ELEPHANT", "OTTER", "BEAVER"}
+	wordList := &game.WordList{}
+	counts := make(map[string]int)
+
+	for i := 0; i < 2000; i++ {
+		word := pool[rng.Intn(len(pool))]
+		if rng.Intn(3) == 0 {
+			wordList.RemoveWord(word)
+			delete(counts, word)
+		} else {
+			wordList.AddWord(word)
+			counts[word]++
+		}
+
+		for length := 4; length <= 8; length++ {
+			want := 0
+			for w, n := range counts {
+				if len(w) == length {
+					want += n
+				}
+			}
+			if got := len(wordList.GetWordsByLength(length, length)); got != want {
+				t.Fatalf("Step %d: expected %d words of length %d, got %d", i, want, length, got)
+			}
+		}
+	}
+}
+
+// generateWords returns n distinct random words of 4 to 15 letters
+func generateWords(n int) []string {
+	rng := rand.New(rand.NewSource(1)) //nolint:gosec // G404: Deterministic test data
+	seen := make(map[string]bool, n)
+	words := make([]string, 0, n)
+	for len(words) < n {
+		letters := make([]byte, 4+rng.Intn(12))
+		for j := range letters {
+			letters[j] = byte('A' + rng.Intn(26))
+		}
+		if word := string(letters); !seen[word] {
+			seen[word] = true
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// benchmarkSize is the size of the generated word lists, similar to a full dictionary
+const benchmarkSize = 500000
+
+func BenchmarkLoadWords(b *testing.B) {
+	text := strings.Join(generateWords(benchmarkSize), "\n")
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		wordList, err := game.LoadWordsFromReader(strings.NewReader(text))
+		if err != nil {
+			b.Fatal(err)
+		}
+		// Include building the index on first use
+		wordList.GetWordsByDifficulty(game.DifficultyEasy)
+	}
+}
+
+func BenchmarkMergeWordSources(b *testing.B) {
+	words := generateWords(benchmarkSize)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		source := &game.WordSource{Name: "generated", Words: words, Enabled: true}
+		wordList := game.MergeWordSources([]*game.WordSource{source})
+		wordList.GetWordsByDifficulty(game.DifficultyEasy)
+	}
+}
+
+func BenchmarkGetWordsByDifficulty(b *testing.B) {
+	wordList := &game.WordList{Words: generateWords(benchmarkSize)}
+	wordList.GetWordsByDifficulty(game.DifficultyEasy)
+
+	for _, difficulty := range []string{game.DifficultyEasy, game.DifficultyMedium, game.DifficultyHard} {
+		b.Run(difficulty, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				wordList.GetWordsByDifficulty(difficulty)
+			}
+		})
+	}
+}
+
+func BenchmarkGetWordsWithLetters(b *testing.B) {
+	wordList := &game.WordList{Words: generateWords(benchmarkSize)}
+	wordList.GetWordsWithLetters(0, "", "")
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		wordList.GetWordsWithLetters(7, "AE", "XYZ")
+	}
+}
+
+func BenchmarkRemoveWord(b *testing.B) {
+	words := generateWords(benchmarkSize)
+	wordList := &game.WordList{Words: words}
+	wordList.GetWordsByLength(0, 0)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		word := words[i%len(words)]
+		wordList.RemoveWord(word)
+		wordList.AddWord(word)
+	}
+	b.StopTimer()
+
+	if wordList.GetWordCount() != len(words) {
+		b.Fatalf("Expected %d words, got %d", len(words), wordList.GetWordCount())
+	}
+}
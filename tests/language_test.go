@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/VinayBhutange/hangman-go/data"
+	"github.com/VinayBhutange/hangman-go/game"
+)
+
+func TestLanguageNormalize(t *testing.T) {
+	de, ok := game.GetLanguage("de")
+	if !ok {
+		t.Fatal("Expected German to be a built-in language")
+	}
+
+	if got := de.Normalize("Straße"); got != "STRASSE" {
+		t.Errorf("Expected STRASSE, got %s", got)
+	}
+
+	if !de.IsPlayable("BRÜCKE") || de.IsPlayable("NIÑO") {
+		t.Error("Unexpected playability for German words")
+	}
+
+	es, _ := game.GetLanguage("es")
+	if got := es.Normalize("canción"); got != "CANCION" {
+		t.Errorf("Expected CANCION, got %s", got)
+	}
+
+	if got := es.Normalize("niño"); got != "NIÑO" {
+		t.Errorf("Expected NIÑO, got %s", got)
+	}
+}
+
+func TestParseLanguageHeader(t *testing.T) {
+	if game.ParseLanguageHeader([]string{"# just a comment"}) != nil {
+		t.Error("Expected nil language without a language line")
+	}
+
+	lang := game.ParseLanguageHeader([]string{"# language: DE"})
+	if lang == nil || lang.Code != "de" || lang.Name != "Deutsch" {
+		t.Errorf("Expected built-in German, got %+v", lang)
+	}
+
+	custom := game.ParseLanguageHeader([]string{"# language: xx", "# alphabet: abc def"})
+	if custom == nil || string(custom.Alphabet) != "ABCDEF" {
+		t.Errorf("Expected custom alphabet ABCDEF, got %+v", custom)
+	}
+}
+
+func TestLoadWordsWithLanguageHeader(t *testing.T) {
+	wordList, err := game.LoadWordsFromReader(strings.NewReader("# language: de\nstraße\nbrücke\n"))
+	if err != nil {
+		t.Fatalf("LoadWordsFromReader failed: %v", err)
+	}
+
+	if wordList.Language == nil || wordList.Language.Code != "de" {
+		t.Fatalf("Expected German word list, got %+v", wordList.Language)
+	}
+
+	if wordList.Words[0] != "STRASSE" || wordList.Words[1] != "BRÜCKE" {
+		t.Errorf("Unexpected words: %v", wordList.Words)
+	}
+}
+
+func TestWordListSetLanguage(t *testing.T) {
+	en := &game.WordSource{Name: "en", Language: "en", Words: []string{"PUZZLE"}, Enabled: true}
+	es := &game.WordSource{Name: "es", Language: "es", Words: []string{"NIÑO", "CANCIÓN"}, Enabled: true}
+	mixed := &game.WordSource{Name: "mixed", Words: []string{"ZORRO", "NAÏVE"}, Enabled: true}
+	wordList := game.MergeWordSources([]*game.WordSource{en, es, mixed})
+
+	spanish, _ := game.GetLanguage("es")
+	unplayable := wordList.SetLanguage(spanish)
+
+	if wordList.GetWordCount() != 3 {
+		t.Errorf("Expected NIÑO, CANCION and ZORRO, got %v", wordList.Words)
+	}
+
+	if wordList.SourceOf("CANCION") != "es" {
+		t.Errorf("Expected folded word to keep its source, got %q", wordList.SourceOf("CANCION"))
+	}
+
+	if len(unplayable) != 1 || unplayable[0] != "NAÏVE" {
+		t.Errorf("Expected NAÏVE to be unplayable, got %v", unplayable)
+	}
+}
+
+func TestDifficultyCountsLetters(t *testing.T) {
+	// NIÑO has five bytes but four letters
+	wordList := &game.WordList{Words: []string{"NIÑO", "CANCIÓN"}}
+
+	if easy := wordList.GetWordsByDifficulty("easy"); len(easy) != 1 || easy[0] != "NIÑO" {
+		t.Errorf("Expected NIÑO to be easy, got %v", easy)
+	}
+}
+
+func TestGuessLetterOutsideASCII(t *testing.T) {
+	g := game.NewGame([]string{"NIÑO"})
+
+	if !g.GuessLetter('ñ') {
+		t.Error("Expected ñ to be in the word")
+	}
+
+	if g.GetDisplayWord() != "_ _ Ñ _" {
+		t.Errorf("Unexpected display word %q", g.GetDisplayWord())
+	}
+}
+
+func TestEmbeddedLanguagePacks(t *testing.T) {
+	for _, code := range data.PackLanguages() {
+		lang, ok := game.GetLanguage(code)
+		if !ok {
+			t.Errorf("Pack language %s is not a built-in language", code)
+			continue
+		}
+
+		wordList, err := game.LoadWordsFromFS(data.Packs, data.PackName(code))
+		if err != nil {
+			t.Errorf("Failed to load %s pack: %v", code, err)
+			continue
+		}
+
+		if wordList.Language == nil || wordList.Language.Code != code {
+			t.Errorf("Pack %s does not declare its language", data.PackName(code))
+		}
+
+		if unplayable := wordList.SetLanguage(lang); len(unplayable) > 0 {
+			t.Errorf("Pack %s has unplayable words: %v", code, unplayable)
+		}
+	}
+}
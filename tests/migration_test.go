@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/VinayBhutange/hangman-go/game"
+)
+
+// writeStatsFile writes stats.json into a temporary home directory and returns its path
+func writeStatsFile(t *testing.T, contents string) string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	dir := filepath.Join(home, ".hangman")
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "stats.json")
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestMigrateLegacyStatistics(t *testing.T) {
+	legacy := `{"games_played": 3, "games_won": 2, "games_lost": 1, "best_game": 1, "difficulties": null, "words_guessed": null}`
+	path := writeStatsFile(t, legacy)
+
+	stats, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+
+	if stats.Version != game.StatsVersion || stats.GamesPlayed != 3 || stats.BestGame != 1 {
+		t.Errorf("Unexpected migrated statistics: %+v", stats)
+	}
+	if stats.Difficulties == nil || stats.Sources == nil || stats.WordsGuessed == nil {
+		t.Error("Expected migration to initialize maps and lists")
+	}
+
+	// The original is kept and the file is upgraded in place
+	backup, err := os.ReadFile(path + ".v1.bak")
+	if err != nil || string(backup) != legacy {
+		t.Errorf("Expected the original file to be backed up, got %q (%v)", backup, err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var upgraded struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &upgraded); err != nil || upgraded.Version != game.StatsVersion {
+		t.Errorf("Expected stats.json to be rewritten as version %d, got %s", game.StatsVersion, data)
+	}
+}
+
+func TestCurrentStatisticsAreNotMigrated(t *testing.T) {
+	path := writeStatsFile(t, `{"version": 3, "games_played": 1, "difficulties": {}, "sources": {}, "words_guessed": [], "by_difficulty": {}, "by_category": {}}`)
+
+	if _, err := game.LoadStatistics(); err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+	if _, err := os.Stat(path + ".v3.bak"); !os.IsNotExist(err) {
+		t.Error("Expected no backup for a current file")
+	}
+}
+
+func TestNewerStatisticsVersion(t *testing.T) {
+	path := writeStatsFile(t, `{"version": 99, "games_played": 1}`)
+
+	_, err := game.LoadStatistics()
+	if !errors.Is(err, game.ErrNewerVersion) {
+		t.Fatalf("Expected ErrNewerVersion, got %v", err)
+	}
+
+	data, _ := os.ReadFile(path) //nolint:errcheck // Compared below
+	if string(data) != `{"version": 99, "games_played": 1}` {
+		t.Error("A newer file must be left untouched")
+	}
+}
+
+func TestUnmigratableStatisticsVersion(t *testing.T) {
+	path := writeStatsFile(t, `{"version": 0, "games_played": 1}`)
+
+	// Moved aside like a corrupt file, with no backup to fall back on
+	if _, err := game.LoadStatistics(); err == nil {
+		t.Fatal("Expected an error for a file without a backup")
+	}
+	if _, err := os.Stat(path + ".corrupt"); err != nil {
+		t.Errorf("Expected the file to be moved aside: %v", err)
+	}
+
+	// Saving works again afterwards
+	stats, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+	stats.RecordGame(playWonGame(), game.DifficultyEasy)
+	if err := stats.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/VinayBhutange/hangman-go/game"
+)
+
+// setDataEnv clears the data directory settings and points HOME at a temporary directory
+func setDataEnv(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(game.HomeEnv, "")
+	t.Setenv("XDG_DATA_HOME", "")
+	t.Setenv("XDG_CONFIG_HOME", "")
+	return home
+}
+
+func TestDataDirDefault(t *testing.T) {
+	home := setDataEnv(t)
+
+	dir, err := game.DataDir()
+	if err != nil || dir != filepath.Join(home, ".hangman") {
+		t.Errorf("Expected ~/.hangman, got %q (%v)", dir, err)
+	}
+}
+
+func TestDataDirXDG(t *testing.T) {
+	home := setDataEnv(t)
+	t.Setenv("XDG_DATA_HOME", filepath.Join(home, "data"))
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "config"))
+
+	if dir, _ := game.DataDir(); dir != filepath.Join(home, "data", "hangman") { //nolint:errcheck // Checked by the path
+		t.Errorf("Expected $XDG_DATA_HOME/hangman, got %q", dir)
+	}
+	if dir, _ := game.ConfigDir(); dir != filepath.Join(home, "config", "hangman") { //nolint:errcheck // Checked by the path
+		t.Errorf("Expected $XDG_CONFIG_HOME/hangman, got %q", dir)
+	}
+
+	// Relative XDG paths are ignored
+	t.Setenv("XDG_DATA_HOME", "relative")
+	if dir, _ := game.DataDir(); dir != filepath.Join(home, ".hangman") { //nolint:errcheck // Checked by the path
+		t.Errorf("Expected a relative XDG_DATA_HOME to be ignored, got %q", dir)
+	}
+}
+
+func TestDataDirKeepsExistingHomeDirectory(t *testing.T) {
+	home := setDataEnv(t)
+	t.Setenv("XDG_DATA_HOME", filepath.Join(home, "data"))
+
+	legacy := filepath.Join(home, ".hangman")
+	if err := os.Mkdir(legacy, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if dir, _ := game.DataDir(); dir != legacy { //nolint:errcheck // Checked by the path
+		t.Errorf("Expected the existing ~/.hangman to be kept, got %q", dir)
+	}
+}
+
+func TestDataDirOverrides(t *testing.T) {
+	home := setDataEnv(t)
+	t.Setenv("XDG_DATA_HOME", filepath.Join(home, "data"))
+	t.Setenv(game.HomeEnv, filepath.Join(home, "env"))
+
+	if dir, _ := game.ConfigDir(); dir != filepath.Join(home, "env") { //nolint:errcheck // Checked by the path
+		t.Errorf("Expected HANGMAN_HOME to win over XDG, got %q", dir)
+	}
+
+	game.SetDataDir(filepath.Join(home, "flag"))
+	defer game.SetDataDir("")
+	if dir, _ := game.DataDir(); dir != filepath.Join(home, "flag") { //nolint:errcheck // Checked by the path
+		t.Errorf("Expected --data-dir to win over HANGMAN_HOME, got %q", dir)
+	}
+}
+
+func TestStatisticsUseDataDir(t *testing.T) {
+	home := setDataEnv(t)
+	dir := filepath.Join(home, "custom")
+	t.Setenv(game.HomeEnv, dir)
+
+	stats := game.NewStatistics()
+	stats.RecordGame(playWonGame(), game.DifficultyEasy)
+	if err := stats.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+	if err := game.NewConfig().SaveConfig(); err != nil {
+		t.Fatalf("SaveConfig failed: %v", err)
+	}
+
+	for _, name := range []string{"stats.json", game.HistoryFile, "config.json"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("Expected %s in the data directory: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/VinayBhutange/hangman-go/game"
+)
+
+// useProfile switches to a profile for the rest of a test
+func useProfile(t *testing.T, name string) {
+	t.Helper()
+	if err := game.SetProfile(name); err != nil {
+		t.Fatalf("SetProfile(%q) failed: %v", name, err)
+	}
+	t.Cleanup(func() {
+		_ = game.SetProfile(game.DefaultProfile) //nolint:errcheck // The default profile always works
+	})
+}
+
+func TestValidateProfileName(t *testing.T) {
+	for _, name := range []string{"alice", "Bob_2", "jörg-k"} {
+		if err := game.ValidateProfileName(name); err != nil {
+			t.Errorf("Expected %q to be valid, got %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "..", "a/b", "with space", "abcdefghijklmnopqrstuvwxyz1234567"} {
+		if err := game.ValidateProfileName(name); !errors.Is(err, game.ErrInvalidProfileName) {
+			t.Errorf("Expected %q to be rejected, got %v", name, err)
+		}
+	}
+}
+
+func TestProfilesKeepSeparateStatistics(t *testing.T) {
+	home := setDataEnv(t)
+
+	// The default profile keeps using the files in the data directory
+	stats := game.NewStatistics()
+	stats.RecordGame(playWonGame(), game.DifficultyEasy)
+	if err := stats.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+
+	useProfile(t, "alice")
+	aliceStats, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+	if aliceStats.GamesPlayed != 0 {
+		t.Errorf("Expected a new profile to start empty, got %d games", aliceStats.GamesPlayed)
+	}
+	aliceStats.RecordGame(playWonGame(), game.DifficultyHard)
+	aliceStats.RecordGame(playWonGame(), game.DifficultyHard)
+	if err := aliceStats.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+
+	profileDir := filepath.Join(home, ".hangman", game.ProfilesDir, "alice")
+	for _, name := range []string{game.StatsFile, game.HistoryFile} {
+		if _, err := os.Stat(filepath.Join(profileDir, name)); err != nil {
+			t.Errorf("Expected %s in the profile directory: %v", name, err)
+		}
+	}
+
+	defaultStats, err := game.LoadProfileStatistics(game.DefaultProfile)
+	if err != nil || defaultStats.GamesPlayed != 1 {
+		t.Errorf("Expected the default profile to keep 1 game, got %+v (%v)", defaultStats, err)
+	}
+
+	profiles, err := game.ListProfiles()
+	if err != nil || !reflect.DeepEqual(profiles, []string{game.DefaultProfile, "alice"}) {
+		t.Errorf("Unexpected profiles %v (%v)", profiles, err)
+	}
+}
+
+func TestLoadProfileStatisticsLeavesFilesAlone(t *testing.T) {
+	setDataEnv(t)
+	dataDir, err := game.DataDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"old", "broken"} {
+		if err := game.CreateProfile(name); err != nil {
+			t.Fatalf("CreateProfile failed: %v", err)
+		}
+	}
+
+	files := map[string]string{
+		"old":    `{"games_played": 2, "games_won": 1, "games_lost": 1}`,
+		"broken": `{"games_played": `,
+	}
+	for name, contents := range files {
+		path := filepath.Join(dataDir, game.ProfilesDir, name, game.StatsFile)
+		if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Old files are upgraded in memory only, and broken ones reported
+	stats, err := game.LoadProfileStatistics("old")
+	if err != nil || stats.GamesPlayed != 2 || stats.Version != game.StatsVersion {
+		t.Errorf("Expected the old file upgraded in memory, got %+v (%v)", stats, err)
+	}
+	if _, err := game.LoadProfileStatistics("broken"); err == nil {
+		t.Error("Expected an error for a broken file")
+	}
+
+	for name, contents := range files {
+		dir := filepath.Join(dataDir, game.ProfilesDir, name)
+		data, err := os.ReadFile(filepath.Join(dir, game.StatsFile))
+		if err != nil || string(data) != contents {
+			t.Errorf("%s: expected stats.json untouched, got %q (%v)", name, data, err)
+		}
+		entries, _ := os.ReadDir(dir) //nolint:errcheck // Checked below
+		for _, entry := range entries {
+			if entry.Name() != game.StatsFile {
+				t.Errorf("%s: expected no other files, found %s", name, entry.Name())
+			}
+		}
+	}
+}
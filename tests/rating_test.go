@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/VinayBhutange/hangman-go/game"
+)
+
+func TestExpectedScore(t *testing.T) {
+	if got := game.ExpectedScore(1500, 1500); got != 0.5 {
+		t.Errorf("Expected evenly rated player and word to score 0.5, got %f", got)
+	}
+	if got := game.ExpectedScore(1900, 1500); math.Abs(got-0.909) > 0.001 {
+		t.Errorf("Expected a 400 point lead to score about 0.909, got %f", got)
+	}
+}
+
+func TestRatingsRecord(t *testing.T) {
+	ratings := game.NewRatings()
+
+	change := ratings.Record("alice", game.NewHistoryEntry(playWonGame(), game.DifficultyEasy))
+	if change.PlayerBefore != game.InitialRating || change.PlayerAfter != game.InitialRating+20 {
+		t.Errorf("Expected a first win to move the player from 1500 to 1520, got %+v", change)
+	}
+	if change.WordAfter != game.InitialRating-20 {
+		t.Errorf("Expected a solved word to lose 20 points, got %.1f", change.WordAfter)
+	}
+
+	// The word is now easier than bob, so losing to it costs more than half
+	change = ratings.Record("bob", game.NewHistoryEntry(playLostGame(), game.DifficultyEasy))
+	if change.PlayerAfter >= game.InitialRating-20 || change.WordAfter <= game.InitialRating-20 {
+		t.Errorf("Expected a loss to an easier word to move both ratings by more than 20, got %+v", change)
+	}
+	if got := ratings.Word("go"); got.Games != 2 {
+		t.Errorf("Expected word ratings to be shared by profiles and case-insensitive, got %+v", got)
+	}
+	if got := ratings.Player("carol"); got.Rating != game.InitialRating || got.Games != 0 {
+		t.Errorf("Expected an unrated profile to start at the initial rating, got %+v", got)
+	}
+}
+
+func TestRecordRatedGameIsSaved(t *testing.T) {
+	setDataEnv(t)
+	useProfile(t, "alice")
+
+	if _, err := game.RecordRatedGame(game.NewHistoryEntry(playWonGame(), game.DifficultyEasy)); err != nil {
+		t.Fatalf("RecordRatedGame failed: %v", err)
+	}
+	ratings, err := game.LoadRatings()
+	if err != nil {
+		t.Fatalf("LoadRatings failed: %v", err)
+	}
+	if got := ratings.Player("alice"); got.Games != 1 || got.Rating <= game.InitialRating {
+		t.Errorf("Expected alice's win to be saved, got %+v", got)
+	}
+	if words := ratings.HardestWords(5); len(words) != 1 || words[0] != testWordGo {
+		t.Errorf("Expected the played word in the hardest words, got %v", words)
+	}
+}
+
+func TestMemoryStoreKeepsRatings(t *testing.T) {
+	home := setDataEnv(t)
+	useMemoryStore(t)
+
+	if _, err := game.RecordRatedGame(game.NewHistoryEntry(playWonGame(), game.DifficultyEasy)); err != nil {
+		t.Fatalf("RecordRatedGame failed: %v", err)
+	}
+	ratings, err := game.LoadRatings()
+	if err != nil {
+		t.Fatalf("LoadRatings failed: %v", err)
+	}
+	if got := ratings.Player(game.DefaultProfile); got.Games != 1 {
+		t.Errorf("Expected the game in the store's ratings, got %+v", got)
+	}
+
+	if _, err := os.Stat(filepath.Join(home, ".hangman", game.RatingsFile)); !os.IsNotExist(err) {
+		t.Errorf("Expected no ratings file on disk, got %v", err)
+	}
+}
+
+func TestLeaderboardRanking(t *testing.T) {
+	entries := []game.LeaderboardEntry{
+		{Profile: "streaky", Rating: game.Rating{Rating: 1500}, WinRate: 50, CurrentStreak: 4},
+		{Profile: "top", Rating: game.Rating{Rating: 1600}, WinRate: 10},
+		{Profile: "steady", Rating: game.Rating{Rating: 1500}, WinRate: 50, CurrentStreak: 1},
+		{Profile: "winner", Rating: game.Rating{Rating: 1500}, WinRate: 80},
+	}
+	game.RankLeaderboard(entries)
+
+	want := []string{"top", "winner", "streaky", "steady"}
+	for i, entry := range entries {
+		if entry.Profile != want[i] {
+			t.Errorf("Expected %s at rank %d, got %s", want[i], i+1, entry.Profile)
+		}
+	}
+}
+
+func TestLeaderboardIncludesEveryProfile(t *testing.T) {
+	setDataEnv(t)
+	if err := game.CreateProfile("alice"); err != nil {
+		t.Fatalf("CreateProfile failed: %v", err)
+	}
+	useProfile(t, "alice")
+	if _, err := game.RecordRatedGame(game.NewHistoryEntry(playWonGame(), game.DifficultyEasy)); err != nil {
+		t.Fatalf("RecordRatedGame failed: %v", err)
+	}
+
+	entries, skipped, err := game.Leaderboard()
+	if err != nil || len(skipped) > 0 {
+		t.Fatalf("Leaderboard failed: %v %v", err, skipped)
+	}
+	if len(entries) != 2 || entries[0].Profile != "alice" || entries[1].Profile != game.DefaultProfile {
+		t.Errorf("Expected alice ahead of the unrated default profile, got %+v", entries)
+	}
+}
+
+func TestLeaderboardSkipsUnreadableProfiles(t *testing.T) {
+	setDataEnv(t)
+	if err := game.CreateProfile("alice"); err != nil {
+		t.Fatalf("CreateProfile failed: %v", err)
+	}
+	dataDir, err := game.DataDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(dataDir, game.ProfilesDir, "alice")
+	// Newer files are left alone rather than recovered
+	if err := os.WriteFile(filepath.Join(dir, game.StatsFile), []byte(`{"version": 99}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, skipped, err := game.Leaderboard()
+	if err != nil {
+		t.Fatalf("Leaderboard failed: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Profile != game.DefaultProfile || len(skipped) != 1 {
+		t.Errorf("Expected alice to be skipped with a warning, got %+v and %v", entries, skipped)
+	}
+}
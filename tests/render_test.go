@@ -0,0 +1,190 @@
+package tests
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/VinayBhutange/hangman-go/game"
+	"github.com/VinayBhutange/hangman-go/i18n"
+	"github.com/VinayBhutange/hangman-go/utils"
+)
+
+// updateGolden rewrites the golden files with the current output
+var updateGolden = flag.Bool("update", false, "rewrite the golden files in testdata/golden")
+
+// checkGolden compares output with testdata/golden/<name>.golden
+func checkGolden(t *testing.T, name string, output []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", "golden", name+".golden")
+
+	if *updateGolden {
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			t.Fatalf("Failed to create golden directory: %v", err)
+		}
+		if err := os.WriteFile(path, output, 0o600); err != nil {
+			t.Fatalf("Failed to write golden file: %v", err)
+		}
+		return
+	}
+
+	//nolint:gosec // G304: File path is controlled by the test
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read golden file (run go test ./tests -update to create it): %v", err)
+	}
+	if !bytes.Equal(output, want) {
+		t.Errorf("Output of %s differs from %s:\n--- got ---\n%s\n--- want ---\n%s", name, path, output, want)
+	}
+}
+
+// midGame returns a game of GOPHER with a hit, a miss and another hit
+func midGame() *game.Game {
+	g := game.NewGameWithSeed([]string{"GOPHER"}, 1)
+	for _, letter := range "GXO" {
+		g.GuessLetter(letter)
+	}
+	return g
+}
+
+func TestRenderGolden(t *testing.T) {
+	defer func() {
+		_ = i18n.SetLocale(i18n.DefaultLocale) //nolint:errcheck // Default locale is embedded
+	}()
+	if err := i18n.SetLocale("en"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Fixed statistics for the statistics screen
+	stats := game.NewStatistics()
+	stats.RecordGame(playWonGame(), game.DifficultyEasy)
+	stats.RecordGame(playLostGame(), game.DifficultyHard)
+	stats.RecordGame(playWonGame(), game.DifficultyEasy)
+	stats.LastPlayed = time.Date(2024, 5, 15, 15, 0, 0, 0, time.UTC)
+
+	now := time.Date(2024, 5, 15, 15, 0, 0, 0, time.Local)
+	history := []game.HistoryEntry{
+		entryOn(now.AddDate(0, 0, -7), true, 2),
+		entryOn(now.AddDate(0, 0, -2), false, 6),
+		entryOn(now.Add(-time.Hour), true, 1),
+		entryOn(now.Add(-2*time.Hour), true, 1),
+	}
+
+	classic, err := game.FindArtPack(game.DefaultArtPack)
+	if err != nil {
+		t.Fatalf("FindArtPack failed: %v", err)
+	}
+
+	screens := []struct {
+		name   string
+		width  int
+		colors utils.ColorLevel
+		render func(r *game.Renderer)
+	}{
+		{"welcome", 0, utils.ColorNone, func(r *game.Renderer) { r.Welcome() }},
+		{"game_state", 0, utils.ColorNone, func(r *game.Renderer) { r.GameState(midGame()) }},
+		{"game_state_color", 0, utils.Color16, func(r *game.Renderer) { r.GameState(midGame()) }},
+		{"keyboard_narrow", 11, utils.ColorNone, func(r *game.Renderer) { r.Keyboard(midGame()) }},
+		{"hangman", 0, utils.ColorNone, func(r *game.Renderer) {
+			for wrong := 0; wrong <= 6; wrong++ {
+				r.Hangman(wrong, 6)
+			}
+		}},
+		{"win", 0, utils.ColorNone, func(r *game.Renderer) { r.WinMessage("GOPHER") }},
+		{"lose", 0, utils.ColorNone, func(r *game.Renderer) { r.LoseMessage("GOPHER") }},
+		{"messages", 0, utils.ColorNone, func(r *game.Renderer) {
+			r.InvalidInput("1")
+			r.AlreadyGuessed('G')
+			r.CorrectGuess('O')
+			r.WrongGuess('X')
+		}},
+		{"game_stats", 0, utils.ColorNone, func(r *game.Renderer) { r.GameStats(4, 3) }},
+		{"statistics", 0, utils.ColorNone, func(r *game.Renderer) { r.Statistics(stats) }},
+		{"analytics", 0, utils.ColorNone, func(r *game.Renderer) { r.Analytics(game.AnalyzeHistory(history)) }},
+		{"trends", 40, utils.ColorNone, func(r *game.Renderer) { r.Trends(history, 2, now) }},
+		{"art_preview", 40, utils.ColorNone, func(r *game.Renderer) { r.ArtPreview(classic, 6) }},
+	}
+
+	for _, screen := range screens {
+		t.Run(screen.name, func(t *testing.T) {
+			var out bytes.Buffer
+			screen.render(game.NewRenderer(&out, screen.width, screen.colors))
+			checkGolden(t, screen.name, out.Bytes())
+		})
+	}
+}
+
+func TestRenderAccessibleGolden(t *testing.T) {
+	defer func() {
+		_ = i18n.SetLocale(i18n.DefaultLocale) //nolint:errcheck // Default locale is embedded
+	}()
+	if err := i18n.SetLocale("en"); err != nil {
+		t.Fatal(err)
+	}
+	game.UseAccessibleMode(true)
+	defer game.UseAccessibleMode(false)
+
+	classic, err := game.FindArtPack(game.DefaultArtPack)
+	if err != nil {
+		t.Fatalf("FindArtPack failed: %v", err)
+	}
+
+	now := time.Date(2024, 5, 15, 15, 0, 0, 0, time.Local)
+	history := []game.HistoryEntry{
+		entryOn(now.AddDate(0, 0, -7), true, 2),
+		entryOn(now.AddDate(0, 0, -2), false, 6),
+		entryOn(now.Add(-time.Hour), true, 1),
+		entryOn(now.Add(-2*time.Hour), true, 1),
+	}
+
+	screens := []struct {
+		name   string
+		render func(r *game.Renderer)
+	}{
+		{"accessible_game_state", func(r *game.Renderer) { r.GameState(midGame()) }},
+		{"accessible_hangman", func(r *game.Renderer) { r.Hangman(3, 6) }},
+		{"accessible_end", func(r *game.Renderer) {
+			r.WinMessage("GOPHER")
+			r.LoseMessage("GOPHER")
+		}},
+		{"accessible_messages", func(r *game.Renderer) {
+			r.InvalidInput("1")
+			r.AlreadyGuessed('G')
+			r.CorrectGuess('O')
+			r.WrongGuess('X')
+		}},
+		{"accessible_art_preview", func(r *game.Renderer) { r.ArtPreview(classic, 6) }},
+		{"accessible_trends", func(r *game.Renderer) { r.Trends(history, 3, now) }},
+	}
+
+	for _, screen := range screens {
+		t.Run(screen.name, func(t *testing.T) {
+			var out bytes.Buffer
+			screen.render(game.NewRenderer(&out, 0, utils.ColorNone))
+			checkGolden(t, screen.name, out.Bytes())
+		})
+	}
+}
+
+func TestAccessibleRevealedPositions(t *testing.T) {
+	game.UseAccessibleMode(true)
+	defer game.UseAccessibleMode(false)
+
+	g := game.NewGameWithSeed([]string{"GOOGLE"}, 1)
+	g.GuessLetter('O')
+	g.GuessLetter('G')
+
+	var out bytes.Buffer
+	game.NewRenderer(&out, 0, utils.ColorNone).GameState(g)
+	for _, want := range []string{
+		"Pattern, 6 letters: G, O, O, G, blank, blank.",
+		"Revealed: G in positions 1, 4; O in positions 2, 3.",
+	} {
+		if !bytes.Contains(out.Bytes(), []byte(want)) {
+			t.Errorf("Expected %q in:\n%s", want, out.String())
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/VinayBhutange/hangman-go/game"
+)
+
+func TestLoadWordSources(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "animals.txt"), []byte("tiger\nzebra\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "Fruits.txt"), []byte("mango\nzebra\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "notes.md"), []byte("ignored\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	sources, err := game.LoadWordSources(dir)
+	if err != nil {
+		t.Fatalf("LoadWordSources failed: %v", err)
+	}
+
+	if len(sources) != 2 {
+		t.Fatalf("Expected 2 sources, got %d", len(sources))
+	}
+
+	if sources[0].Name != "fruits" || sources[1].Name != "animals" {
+		// Glob sorts byte-wise, so "Fruits.txt" comes before "animals.txt"
+		t.Errorf("Unexpected source names: %s, %s", sources[0].Name, sources[1].Name)
+	}
+
+	if _, err := game.LoadWordSources(filepath.Join(dir, "missing")); err == nil {
+		t.Error("Expected error for missing directory")
+	}
+}
+
+func TestMergeWordSources(t *testing.T) {
+	animals := &game.WordSource{Name: "animals", Words: []string{"TIGER", "ZEBRA"}, Enabled: true}
+	fruits := &game.WordSource{Name: "fruits", Words: []string{"MANGO", "ZEBRA"}, Enabled: true}
+
+	wordList := game.MergeWordSources([]*game.WordSource{animals, fruits})
+
+	if wordList.GetWordCount() != 3 {
+		t.Errorf("Expected 3 merged words, got %v", wordList.Words)
+	}
+
+	if wordList.SourceOf("zebra") != "animals" {
+		t.Errorf("Expected ZEBRA to be tagged with the first source, got %q", wordList.SourceOf("zebra"))
+	}
+
+	if wordList.SourceOf("MANGO") != "fruits" {
+		t.Errorf("Expected MANGO to be tagged fruits, got %q", wordList.SourceOf("MANGO"))
+	}
+
+	if err := wordList.SetSourceEnabled("animals", false); err != nil {
+		t.Fatalf("SetSourceEnabled failed: %v", err)
+	}
+
+	if wordList.GetWordCount() != 2 || wordList.SourceOf("ZEBRA") != "fruits" {
+		t.Errorf("Expected only fruits words after disabling animals, got %v", wordList.Words)
+	}
+
+	if err := wordList.SetSourceEnabled("missing", true); err == nil {
+		t.Error("Expected error for unknown source")
+	}
+}
+
+func TestAddAndRemoveWordWithSources(t *testing.T) {
+	animals := &game.WordSource{Name: "animals", Words: []string{"TIGER", "ZEBRA"}, Enabled: true}
+	wordList := game.MergeWordSources([]*game.WordSource{animals})
+
+	wordList.AddWord("puzzle")
+	if wordList.SourceOf("PUZZLE") != game.CustomSource {
+		t.Errorf("Expected added word to be tagged %q, got %q", game.CustomSource, wordList.SourceOf("PUZZLE"))
+	}
+
+	wordList.RemoveWord("tiger")
+	if wordList.GetWordCount() != 2 || len(animals.Words) != 1 {
+		t.Errorf("Expected TIGER to be removed from list and source, got %v / %v", wordList.Words, animals.Words)
+	}
+}
+
+func TestRecordGameBySource(t *testing.T) {
+	stats := game.NewStatistics()
+
+	g := game.NewGame([]string{testWordGolang})
+	g.Source = "animals"
+	stats.RecordGame(g, "easy")
+
+	if stats.Sources["animals"] != 1 {
+		t.Errorf("Expected 1 game for source animals, got %d", stats.Sources["animals"])
+	}
+}
+
+func TestCustomWordsAreSaved(t *testing.T) {
+	home := setDataEnv(t)
+	useProfile(t, "casey")
+
+	if err := game.SaveCustomWord("puzzle"); err != nil {
+		t.Fatalf("SaveCustomWord failed: %v", err)
+	}
+	if err := game.SaveRemovedWord("tiger"); err != nil {
+		t.Fatalf("SaveRemovedWord failed: %v", err)
+	}
+
+	// The custom words load back as the custom source of the profile
+	dir, err := game.ProfileWordsDir()
+	if err != nil {
+		t.Fatalf("ProfileWordsDir failed: %v", err)
+	}
+	if want := filepath.Join(home, ".hangman", "profiles", "casey", "words"); dir != want {
+		t.Errorf("Expected the word directory %s, got %s", want, dir)
+	}
+	sources, err := game.LoadWordSources(dir)
+	if err != nil {
+		t.Fatalf("LoadWordSources failed: %v", err)
+	}
+	wordList := game.MergeWordSources(append([]*game.WordSource{
+		{Name: "animals", Words: []string{"TIGER", "ZEBRA"}, Enabled: true},
+	}, sources...))
+
+	removed, err := game.LoadRemovedWords()
+	if err != nil || len(removed) != 1 || removed[0] != "TIGER" {
+		t.Fatalf("Expected TIGER to be removed, got %v (%v)", removed, err)
+	}
+	wordList.RemoveWords(removed)
+	if wordList.SourceOf("PUZZLE") != game.CustomSource || wordList.GetWordCount() != 2 {
+		t.Errorf("Expected ZEBRA and the custom PUZZLE, got %v", wordList.Words)
+	}
+
+	// Adding a removed word back takes it off the removed words, and removing
+	// a custom word drops it from the custom words
+	if err := game.SaveCustomWord("tiger"); err != nil {
+		t.Fatalf("SaveCustomWord failed: %v", err)
+	}
+	if err := game.SaveRemovedWord("puzzle"); err != nil {
+		t.Fatalf("SaveRemovedWord failed: %v", err)
+	}
+	if removed, _ := game.LoadRemovedWords(); len(removed) != 1 || removed[0] != "PUZZLE" { //nolint:errcheck // Checked above
+		t.Errorf("Expected only PUZZLE to be removed, got %v", removed)
+	}
+	custom, err := game.ReadWordFile(filepath.Join(dir, game.CustomWordsFile))
+	if err != nil || len(custom.Entries) != 1 || custom.Entries[0] != "tiger" {
+		t.Errorf("Expected only tiger in the custom words, got %+v (%v)", custom, err)
+	}
+}
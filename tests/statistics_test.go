@@ -1,12 +1,25 @@
 package tests
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/VinayBhutange/hangman-go/game"
 )
 
+// useMemoryStore keeps statistics and history in memory for the rest of a test
+func useMemoryStore(t *testing.T) *game.MemoryStore {
+	t.Helper()
+	store := game.NewMemoryStore()
+	game.SetStatsStore(store)
+	t.Cleanup(func() {
+		game.SetStatsStore(nil)
+	})
+	return store
+}
+
 func TestNewStatistics(t *testing.T) {
 	stats := game.NewStatistics()
 
@@ -268,3 +281,117 @@ func TestResetStatistics(t *testing.T) {
 		t.Errorf("Expected WordsGuessed to be empty after reset, got %d items", len(stats.WordsGuessed))
 	}
 }
+
+func TestMemoryStoreMergesConcurrentGames(t *testing.T) {
+	useMemoryStore(t)
+
+	first, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+	second, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+	first.RecordGame(playWonGame(), game.DifficultyEasy)
+	second.RecordGame(playLostGame(), game.DifficultyHard)
+	if err := first.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+	if err := second.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+
+	stats, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+	if stats.GamesPlayed != 2 || stats.GamesWon != 1 || stats.GamesLost != 1 {
+		t.Errorf("Expected both games to be kept, got %+v", stats)
+	}
+	history, err := game.LoadHistory()
+	if err != nil {
+		t.Fatalf("LoadHistory failed: %v", err)
+	}
+	if len(history) != 2 {
+		t.Errorf("Expected both games in the history, got %d", len(history))
+	}
+
+	// Loaded statistics are copies; changing one doesn't change the store
+	stats.Difficulties[game.DifficultyEasy] = 99
+	if again, _ := game.LoadStatistics(); again.Difficulties[game.DifficultyEasy] != 1 { //nolint:errcheck // Memory stores only fail on corrupt data
+		t.Errorf("Expected the store to be unaffected by changes to loaded statistics, got %v", again.Difficulties)
+	}
+}
+
+func TestMemoryStoreReset(t *testing.T) {
+	useMemoryStore(t)
+
+	stats := game.NewStatistics()
+	stats.RecordGame(playWonGame(), game.DifficultyEasy)
+	if err := stats.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+	stats.ResetStatistics()
+	if err := stats.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+
+	saved, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+	if saved.GamesPlayed != 0 {
+		t.Errorf("Expected the reset to replace the saved statistics, got %d games", saved.GamesPlayed)
+	}
+}
+
+func TestFileStoreUsesItsOwnPath(t *testing.T) {
+	setDataEnv(t)
+	path := filepath.Join(t.TempDir(), "elsewhere", game.StatsFile)
+	store := game.NewFileStore(path)
+
+	stats, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	stats.RecordGame(playWonGame(), game.DifficultyEasy)
+	if err := stats.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("Expected statistics to be saved to the store's path: %v", err)
+	}
+	if history, _ := store.LoadHistory(); len(history) != 1 { //nolint:errcheck // Checked by the count
+		t.Errorf("Expected the game in the store's history, got %d", len(history))
+	}
+	if defaultStats, _ := game.LoadStatistics(); defaultStats.GamesPlayed != 0 { //nolint:errcheck // Checked by the count
+		t.Errorf("Expected the data directory to be untouched, got %d games", defaultStats.GamesPlayed)
+	}
+}
+
+func TestDirStoreKeepsProfilesApart(t *testing.T) {
+	root := t.TempDir()
+
+	alice := game.NewDirStore(root, "alice")
+	stats, err := alice.Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	stats.RecordGame(playWonGame(), game.DifficultyEasy)
+	if err := alice.Save(stats); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(root, game.ProfilesDir, "alice", game.StatsFile)); err != nil {
+		t.Errorf("Expected alice's statistics in the profile's directory: %v", err)
+	}
+	other, err := game.NewDirStore(root, game.DefaultProfile).Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if other.GamesPlayed != 0 {
+		t.Errorf("Expected the default profile to be separate, got %d games", other.GamesPlayed)
+	}
+}
@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/VinayBhutange/hangman-go/game"
+)
+
+func TestSaveStatisticsMergesConcurrentGames(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	// Two processes load the same statistics and each plays a game
+	first, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+	second, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+
+	first.RecordGame(playWonGame(), game.DifficultyEasy)
+	second.RecordGame(playWonGame(), game.DifficultyHard)
+	if err := first.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+	if err := second.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+
+	stats, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+	if stats.GamesPlayed != 2 || stats.GamesWon != 2 || stats.CurrentStreak != 2 {
+		t.Errorf("Expected both games to be kept, got %+v", stats)
+	}
+	if stats.Difficulties[game.DifficultyEasy] != 1 || stats.Difficulties[game.DifficultyHard] != 1 {
+		t.Errorf("Expected one game per difficulty, got %v", stats.Difficulties)
+	}
+	if second.GamesPlayed != 2 {
+		t.Errorf("Expected the saving process to see the merged statistics, got %d games", second.GamesPlayed)
+	}
+}
+
+func TestResetStatisticsIsNotMerged(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	stats := game.NewStatistics()
+	stats.RecordGame(playWonGame(), game.DifficultyEasy)
+	if err := stats.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+
+	stats.ResetStatistics()
+	if err := stats.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+
+	loaded, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+	if loaded.GamesPlayed != 0 {
+		t.Errorf("Expected reset statistics to replace the file, got %d games", loaded.GamesPlayed)
+	}
+}
+
+func TestLoadStatisticsRecoversFromBackup(t *testing.T) {
+	path := writeStatsFile(t, `{"version": 2, "games_played": 4, "difficulties": {}, "sources": {}, "words_guessed": []}`)
+
+	// Saving keeps the replaced file as the last good backup
+	stats, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("LoadStatistics failed: %v", err)
+	}
+	stats.RecordGame(playWonGame(), game.DifficultyEasy)
+	if err := stats.SaveStatistics(); err != nil {
+		t.Fatalf("SaveStatistics failed: %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"version": 2, "games_pl`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	recovered, err := game.LoadStatistics()
+	if err != nil {
+		t.Fatalf("Expected recovery from the backup, got %v", err)
+	}
+	if recovered.GamesPlayed != 4 || recovered.RecoveredFrom != path+".bak" {
+		t.Errorf("Expected the backup with 4 games, got %d games from %q", recovered.GamesPlayed, recovered.RecoveredFrom)
+	}
+	if _, err := os.Stat(path + ".corrupt"); err != nil {
+		t.Errorf("Expected the corrupt file to be moved aside: %v", err)
+	}
+}
+
+func TestLoadStatisticsCorruptWithoutBackup(t *testing.T) {
+	writeStatsFile(t, `not json`)
+
+	if _, err := game.LoadStatistics(); err == nil {
+		t.Error("Expected an error for a corrupt file without a backup")
+	}
+}
+
+func TestSaveLeavesNoTemporaryFiles(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	stats := game.NewStatistics()
+	stats.RecordGame(playWonGame(), game.DifficultyEasy)
+	for i := 0; i < 3; i++ {
+		if err := stats.SaveStatistics(); err != nil {
+			t.Fatalf("SaveStatistics failed: %v", err)
+		}
+	}
+	if err := (&game.Config{}).SaveConfig(); err != nil {
+		t.Fatalf("SaveConfig failed: %v", err)
+	}
+
+	files, err := os.ReadDir(filepath.Join(home, ".hangman"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		if strings.Contains(file.Name(), ".tmp") {
+			t.Errorf("Unexpected temporary file left behind: %s", file.Name())
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/VinayBhutange/hangman-go/terminal"
+)
+
+// chunkReader returns one chunk per Read, like keypresses arriving from a terminal
+type chunkReader struct {
+	chunks []string
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	if len(r.chunks) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, r.chunks[0])
+	r.chunks = r.chunks[1:]
+	return n, nil
+}
+
+func TestScreenReadKey(t *testing.T) {
+	in := &chunkReader{chunks: []string{"g", "\033[A", "\033", "\x03", "\xc3", "\xa9", "\r"}}
+	screen := terminal.NewScreen(in, io.Discard)
+
+	want := []rune{'g', terminal.KeyNone, terminal.KeyEscape, terminal.KeyInterrupt, 'é', terminal.KeyEnter}
+	for _, key := range want {
+		got, err := screen.ReadKey()
+		if err != nil {
+			t.Fatalf("ReadKey failed: %v", err)
+		}
+		if got != key {
+			t.Errorf("Expected key %q, got %q", key, got)
+		}
+	}
+	if _, err := screen.ReadKey(); err != io.EOF {
+		t.Errorf("Expected io.EOF once input ends, got %v", err)
+	}
+}
+
+func TestScreenDraw(t *testing.T) {
+	var out bytes.Buffer
+	screen := terminal.NewScreen(strings.NewReader(""), &out)
+
+	if err := screen.Draw("first\nsecond"); err != nil {
+		t.Fatalf("Draw failed: %v", err)
+	}
+	// Drawn from the top left, with the rest of each line and the screen cleared
+	want := "\033[Hfirst\033[K\nsecond\033[K\033[J"
+	if out.String() != want {
+		t.Errorf("Expected %q, got %q", want, out.String())
+	}
+
+	if err := screen.Close(); err != nil {
+		t.Errorf("Close failed: %v", err)
+	}
+	if err := screen.Close(); err != nil {
+		t.Errorf("Expected a second Close to do nothing, got %v", err)
+	}
+	if screen.Width() != 80 {
+		t.Errorf("Expected the default width off a terminal, got %d", screen.Width())
+	}
+}
@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VinayBhutange/hangman-go/game"
+	"github.com/VinayBhutange/hangman-go/utils"
+)
+
+// entryOn returns a game played at t
+func entryOn(t time.Time, won bool, wrong int) game.HistoryEntry {
+	result := game.ResultLost
+	if won {
+		result = game.ResultWon
+	}
+	return game.HistoryEntry{PlayedAt: t, Word: testWordGo, WrongGuesses: wrong, Result: result}
+}
+
+func TestDailyAndWeeklyTrends(t *testing.T) {
+	// Wednesday afternoon
+	now := time.Date(2024, 5, 15, 15, 0, 0, 0, time.Local)
+	history := []game.HistoryEntry{
+		entryOn(now.AddDate(0, 0, -20), true, 0), // Too old for either
+		entryOn(now.AddDate(0, 0, -7), true, 2),  // Previous Wednesday
+		entryOn(now.AddDate(0, 0, -2), false, 6), // This Monday
+		entryOn(now.Add(-time.Hour), true, 1),    // Today
+		entryOn(now.Add(-2*time.Hour), true, 3),  // Today
+	}
+
+	daily := game.DailyTrends(history, 3, now)
+	if len(daily) != 3 || !daily[2].Start.Equal(time.Date(2024, 5, 15, 0, 0, 0, 0, time.Local)) {
+		t.Fatalf("Expected 3 days ending today, got %+v", daily)
+	}
+	if daily[0].Games != 1 || daily[1].Games != 0 || daily[2].Games != 2 {
+		t.Errorf("Unexpected games per day: %+v", daily)
+	}
+	if daily[2].WinRate() != 100 || daily[2].AverageWrongGuesses() != 2 {
+		t.Errorf("Unexpected stats for today: %+v", daily[2])
+	}
+
+	weekly := game.WeeklyTrends(history, 2, now)
+	if len(weekly) != 2 || weekly[1].Start.Weekday() != time.Monday || weekly[1].Start.Day() != 13 {
+		t.Fatalf("Expected 2 weeks ending with the week of Monday the 13th, got %+v", weekly)
+	}
+	if weekly[0].Games != 1 || weekly[1].Games != 3 || weekly[1].Won != 2 {
+		t.Errorf("Unexpected weekly games: %+v", weekly)
+	}
+}
+
+func TestWrongGuessDistribution(t *testing.T) {
+	now := time.Now()
+	history := []game.HistoryEntry{
+		entryOn(now, true, 0), entryOn(now, true, 2), entryOn(now, true, 2), entryOn(now, false, 6),
+	}
+	want := []int{1, 0, 2, 0, 0, 0}
+	if got := game.WrongGuessDistribution(history); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	// Impossible counts are left out instead of indexing out of range
+	history = append(history, entryOn(now, true, -1))
+	if got := game.WrongGuessDistribution(history); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v with a negative count, got %v", want, got)
+	}
+}
+
+func TestCharts(t *testing.T) {
+	if got := game.Sparkline([]float64{0, 1, 2, 4}); got != "▁▂▄█" {
+		t.Errorf("Unexpected sparkline %q", got)
+	}
+
+	lines := game.BarChart([]string{"0", "10"}, []int{4, 1}, 8)
+	if lines[0] != " 0 | ████████ 4" || lines[1] != "10 | ██ 1" {
+		t.Errorf("Unexpected bar chart %q", lines)
+	}
+
+	game.UseASCIICharts(true)
+	defer game.UseASCIICharts(false)
+	if got := game.Sparkline([]float64{0, 4}); got != "_@" {
+		t.Errorf("Unexpected ASCII sparkline %q", got)
+	}
+	if line := game.BarChart([]string{"a"}, []int{3}, 3)[0]; strings.ContainsRune(line, '█') {
+		t.Errorf("Expected an ASCII bar, got %q", line)
+	}
+}
+
+func TestASCIIChartsFollowLocale(t *testing.T) {
+	tests := []struct {
+		vars map[string]string
+		want bool
+	}{
+		{nil, false},
+		{map[string]string{"LANG": "en_US.UTF-8"}, false},
+		{map[string]string{"LANG": "de_DE.utf8"}, false},
+		{map[string]string{"LANG": "C"}, true},
+		{map[string]string{"LANG": "en_US.ISO-8859-1"}, true},
+		{map[string]string{"LANG": "en_US.UTF-8", "LC_ALL": "POSIX"}, true},
+		{map[string]string{"LANG": "C", "LC_CTYPE": "C.UTF-8"}, false},
+	}
+	for _, tt := range tests {
+		if got := game.DetectASCIICharts(env(tt.vars)); got != tt.want {
+			t.Errorf("%v: expected %v, got %v", tt.vars, tt.want, got)
+		}
+	}
+
+	// A C locale draws the trends screen without block characters
+	game.UseASCIICharts(game.DetectASCIICharts(env(map[string]string{"LANG": "C"})))
+	defer game.UseASCIICharts(false)
+	now := time.Now()
+	var out bytes.Buffer
+	game.NewRenderer(&out, 40, utils.ColorNone).Trends([]game.HistoryEntry{entryOn(now, true, 1)}, 2, now)
+	if strings.ContainsAny(out.String(), "▁█") || !strings.Contains(out.String(), "#") {
+		t.Errorf("Expected ASCII charts, got %q", out.String())
+	}
+}
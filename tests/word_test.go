@@ -1,8 +1,10 @@
 package tests
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/VinayBhutange/hangman-go/data"
 	"github.com/VinayBhutange/hangman-go/game"
 )
 
@@ -161,3 +163,29 @@ func TestGetWordCount(t *testing.T) {
 		t.Errorf("Expected word count %d, got %d", len(words), count)
 	}
 }
+
+func TestLoadWordsFromReader(t *testing.T) {
+	wordList, err := game.LoadWordsFromReader(strings.NewReader("golang\n  \ngo\nprogramming\n"))
+	if err != nil {
+		t.Fatalf("LoadWordsFromReader failed: %v", err)
+	}
+
+	if wordList.GetWordCount() != 2 {
+		t.Errorf("Expected 2 words, got %v", wordList.Words)
+	}
+
+	if _, err := game.LoadWordsFromReader(strings.NewReader("\n")); err == nil {
+		t.Error("Expected error for reader without words")
+	}
+}
+
+func TestLoadEmbeddedPack(t *testing.T) {
+	wordList, err := game.LoadWordsFromFS(data.Packs, data.DefaultPack)
+	if err != nil {
+		t.Fatalf("Failed to load embedded pack: %v", err)
+	}
+
+	if wordList.GetWordCount() == 0 {
+		t.Error("Expected embedded pack to contain words")
+	}
+}
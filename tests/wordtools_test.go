@@ -0,0 +1,183 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/VinayBhutange/hangman-go/game"
+)
+
+func TestAnalyzeWords(t *testing.T) {
+	entries := []string{"golang", "GOLANG", "GoLang", "go", "test123", "puzzle", "programming"}
+	report := (&game.WordFile{Entries: entries}).Analyze(nil)
+
+	if report.Total != len(entries) {
+		t.Errorf("Expected Total to be %d, got %d", len(entries), report.Total)
+	}
+
+	if report.Unique != 3 {
+		t.Errorf("Expected 3 unique words, got %d", report.Unique)
+	}
+
+	if report.Duplicates[testWordGolang] != 3 {
+		t.Errorf("Expected GOLANG to appear 3 times, got %d", report.Duplicates[testWordGolang])
+	}
+
+	if len(report.Invalid) != 2 {
+		t.Errorf("Expected 2 invalid entries, got %v", report.Invalid)
+	}
+
+	if len(report.MixedCase) != 1 || report.MixedCase[0] != "GoLang" {
+		t.Errorf("Expected GoLang to be reported as mixed case, got %v", report.MixedCase)
+	}
+
+	if report.LengthHistogram[6] != 2 {
+		t.Errorf("Expected 2 words of length 6, got %d", report.LengthHistogram[6])
+	}
+
+	if report.DifficultyCounts[game.DifficultyMedium] != 2 || report.DifficultyCounts[game.DifficultyHard] != 1 {
+		t.Errorf("Unexpected difficulty counts: %v", report.DifficultyCounts)
+	}
+
+	if !report.HasIssues() {
+		t.Error("Expected report to have issues")
+	}
+}
+
+func TestNormalizeAndSortWords(t *testing.T) {
+	wordFile := &game.WordFile{Entries: []string{"zebra", "Apple", "ZEBRA", "no", "mango"}}
+
+	normalized := wordFile.Normalized()
+	expected := []string{"ZEBRA", "APPLE", "MANGO"}
+	if len(normalized) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, normalized)
+	}
+	for i := range expected {
+		if normalized[i] != expected[i] {
+			t.Errorf("Expected %v, got %v", expected, normalized)
+			break
+		}
+	}
+
+	sorted := wordFile.Sorted()
+	if sorted[0] != "APPLE" || sorted[2] != "ZEBRA" {
+		t.Errorf("Expected sorted words, got %v", sorted)
+	}
+}
+
+func TestWriteAndReadWordFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "words.txt")
+
+	if err := game.WriteWordFile(filename, []string{"# language: es"}, []string{"APPLE", "MANGO"}); err != nil {
+		t.Fatalf("WriteWordFile failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to read written file: %v", err)
+	}
+	if string(data) != "# language: es\napple\nmango\n" {
+		t.Errorf("Unexpected file contents: %q", string(data))
+	}
+
+	wordFile, err := game.ReadWordFile(filename)
+	if err != nil {
+		t.Fatalf("ReadWordFile failed: %v", err)
+	}
+	if len(wordFile.Entries) != 2 || len(wordFile.Header) != 1 {
+		t.Errorf("Expected 2 entries and 1 header line, got %v / %v", wordFile.Entries, wordFile.Header)
+	}
+	if wordFile.Language == nil || wordFile.Language.Code != "es" {
+		t.Errorf("Expected language es from header, got %v", wordFile.Language)
+	}
+}
+
+func TestRemoveWordRemovesAllOccurrences(t *testing.T) {
+	wordList := &game.WordList{Words: []string{testWordGolang, "PUZZLE", testWordGolang}}
+
+	wordList.RemoveWord("golang")
+
+	if len(wordList.Words) != 1 || wordList.Words[0] != "PUZZLE" {
+		t.Errorf("Expected only PUZZLE to remain, got %v", wordList.Words)
+	}
+}
+
+func TestDifficultyForWord(t *testing.T) {
+	cases := map[string]string{
+		"CAT":         game.DifficultyUnrated,
+		"LANG":        game.DifficultyEasy,
+		"GOLANG":      game.DifficultyMedium,
+		"PROGRAMMING": game.DifficultyHard,
+	}
+
+	for word, expected := range cases {
+		if got := game.DifficultyForWord(word); got != expected {
+			t.Errorf("Expected %s for %s, got %s", expected, word, got)
+		}
+	}
+}
+
+func TestAnalyzeWordsReportsBlocked(t *testing.T) {
+	wordFile := &game.WordFile{Entries: []string{"puzzle", "badword", "BADWORD"}}
+	report := wordFile.Analyze(game.NewBlocklist("badword"))
+
+	if len(report.Blocked) != 1 || report.Blocked[0] != "BADWORD" {
+		t.Errorf("Expected BADWORD to be reported once, got %v", report.Blocked)
+	}
+}
+
+func TestWordFileWithLanguage(t *testing.T) {
+	es, _ := game.GetLanguage("es")
+	wordFile := &game.WordFile{Entries: []string{"Zorro", "niño", "canción", "año"}, Language: es}
+
+	report := wordFile.Analyze(nil)
+	if len(report.Invalid) != 0 {
+		t.Errorf("Expected Spanish words to be valid, got invalid %v", report.Invalid)
+	}
+
+	sorted := wordFile.Sorted()
+	expected := []string{"AÑO", "CANCIÓN", "NIÑO", "ZORRO"}
+	for i := range expected {
+		if sorted[i] != expected[i] {
+			t.Errorf("Expected %v, got %v", expected, sorted)
+			break
+		}
+	}
+}
+
+func TestSortKeepsAccents(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]struct{ contents, want string }{
+		"es.txt": {
+			"# language: es\ncanción\nárbol\nCancion\nniño\nzorro\n",
+			"# language: es\nárbol\ncanción\nniño\nzorro\n",
+		},
+		"de.txt": {
+			"# language: de\nstraße\nÄpfel\nstrasse\nbrücke\n",
+			"# language: de\nbrücke\nstraße\näpfel\n",
+		},
+	}
+
+	for name, file := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(file.contents), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		wordFile, err := game.ReadWordFile(path)
+		if err != nil {
+			t.Fatalf("ReadWordFile failed: %v", err)
+		}
+		if err := game.WriteWordFile(path, wordFile.Header, wordFile.Sorted()); err != nil {
+			t.Fatalf("WriteWordFile failed: %v", err)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != file.want {
+			t.Errorf("%s: expected %q, got %q", name, file.want, data)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/VinayBhutange/hangman-go/game"
+	"github.com/VinayBhutange/hangman-go/i18n"
+	"github.com/VinayBhutange/hangman-go/terminal"
+	"github.com/VinayBhutange/hangman-go/utils"
+)
+
+// playFullScreen plays a game on a full-screen board that is redrawn in place,
+// guessing with single keypresses. It returns with the game unfinished if the
+// terminal can't be read, so it can be finished line by line.
+func playFullScreen(screen *terminal.Screen, g *game.Game) {
+	defer func() {
+		// Deferred calls also run when panicking, so the terminal is always restored
+		_ = screen.Close() //nolint:errcheck // Nothing more can be done about it
+	}()
+
+	message := utils.Info(i18n.T("game.starting")) + " " + i18n.N("game.word_length", utf8.RuneCountInString(g.Word))
+	for !g.IsGameOver {
+		if err := drawBoard(screen, g, message); err != nil {
+			return
+		}
+
+		key, err := screen.ReadKey()
+		if err != nil {
+			return
+		}
+
+		switch key {
+		case terminal.KeyInterrupt, terminal.KeyEOF:
+			_ = screen.Close() //nolint:errcheck // Exiting anyway
+			os.Exit(130)
+		case terminal.KeyNone, terminal.KeyEnter, terminal.KeyEscape, ' ':
+			continue
+		}
+
+		letter, _ := utf8.DecodeRuneInString(strings.ToUpper(string(key)))
+		switch {
+		case !utils.IsLetter(letter):
+			message = utils.Error(i18n.T("input.valid_letter", utils.DescribeAlphabet()))
+		case g.GuessedLetters[letter]:
+			message = utils.Warning(i18n.T("game.already_guessed", letter))
+		case g.GuessLetter(letter):
+			message = utils.Success(i18n.T("game.correct_guess", letter))
+		default:
+			message = utils.Error(i18n.T("game.wrong_guess", letter))
+		}
+	}
+}
+
+// drawBoard redraws the game board with a message about the last key pressed
+func drawBoard(screen *terminal.Screen, g *game.Game, message string) error {
+	var frame bytes.Buffer
+	game.NewRenderer(&frame, screen.Width(), utils.Colors()).GameState(g)
+	fmt.Fprintln(&frame, message)
+	fmt.Fprintln(&frame)
+	fmt.Fprint(&frame, i18n.T("tui.prompt"))
+	return screen.Draw(frame.String())
+}
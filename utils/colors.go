@@ -2,8 +2,12 @@
 package utils
 
 import (
+	"errors"
 	"os"
 	"runtime"
+	"strings"
+
+	"github.com/VinayBhutange/hangman-go/i18n"
 )
 
 // Color codes for terminal output
@@ -19,21 +23,112 @@ const (
 	ColorBold   = "\033[1m"
 )
 
-// ColorSupported checks if the terminal supports color output
-func ColorSupported() bool {
-	// Disable colors on Windows for now (can be enhanced later)
-	if runtime.GOOS == "windows" {
-		return false
+// ColorMode says when to use colors, as given with --color
+type ColorMode string
+
+// Color modes
+const (
+	ColorAuto   ColorMode = "auto"   // When writing to a terminal that supports them
+	ColorAlways ColorMode = "always" // Even into pipes and files
+	ColorNever  ColorMode = "never"  // Plain text only
+)
+
+// ColorLevel is how many colors the terminal can show
+type ColorLevel int
+
+// Color levels, from plain text to 24-bit color
+const (
+	ColorNone ColorLevel = iota // No colors
+	Color16                     // The 16 basic ANSI colors
+	Color256                    // The xterm 256-color palette
+	ColorTrue                   // 24-bit RGB colors
+)
+
+// ErrUnknownColorMode is returned for color modes other than auto, always and never
+var ErrUnknownColorMode = errors.New("unknown color mode, use auto, always or never")
+
+// colorMode and colorLevel are the chosen mode and the level it resolved to
+var (
+	colorMode     = ColorAuto
+	colorLevel    ColorLevel
+	colorDetected bool
+)
+
+// ParseColorMode parses a --color value
+func ParseColorMode(value string) (ColorMode, error) {
+	switch mode := ColorMode(strings.ToLower(value)); mode {
+	case ColorAuto, ColorAlways, ColorNever:
+		return mode, nil
+	default:
+		return ColorAuto, ErrUnknownColorMode
 	}
+}
 
-	// Check if we're in a terminal that likely supports colors
-	term := os.Getenv("TERM")
-	return term != "" && term != "dumb"
+// SetColorMode chooses when colors are used, detecting the color level again
+func SetColorMode(mode ColorMode) {
+	colorMode = mode
+	colorDetected = false
+}
+
+// Colors returns the color level of standard output, detected once from the
+// color mode, the environment and whether it is a terminal
+func Colors() ColorLevel {
+	if !colorDetected {
+		colorLevel = DetectColorLevel(colorMode, os.Getenv, isTerminal(os.Stdout))
+		colorDetected = true
+	}
+	return colorLevel
+}
+
+// DetectColorLevel works out the color level for a color mode. In auto mode,
+// NO_COLOR turns colors off, FORCE_COLOR turns them on (FORCE_COLOR=0 off),
+// and otherwise output must be a terminal whose TERM isn't dumb. How many
+// colors are used follows COLORTERM, TERM and FORCE_COLOR=2 or 3.
+func DetectColorLevel(mode ColorMode, getenv func(string) string, tty bool) ColorLevel {
+	force := getenv("FORCE_COLOR")
+	switch {
+	case mode == ColorNever:
+		return ColorNone
+	case mode == ColorAlways:
+		// Always color, as richly as the terminal seems to allow
+	case getenv("NO_COLOR") != "":
+		return ColorNone
+	case force == "0" || force == "false":
+		return ColorNone
+	case force != "":
+		// Forced on whatever the output is
+	case !tty, getenv("TERM") == "", getenv("TERM") == "dumb":
+		return ColorNone
+	case runtime.GOOS == "windows" && getenv("WT_SESSION") == "":
+		// Only Windows Terminal is known to understand ANSI colors
+		return ColorNone
+	}
+
+	colorTerm := strings.ToLower(getenv("COLORTERM"))
+	switch {
+	case colorTerm == "truecolor" || colorTerm == "24bit" || force == "3":
+		return ColorTrue
+	case strings.Contains(getenv("TERM"), "256color") || force == "2":
+		return Color256
+	default:
+		return Color16
+	}
+}
+
+// isTerminal reports whether a file is a terminal rather than a pipe or file
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// ColorSupported reports whether colors are used, see Colors
+func ColorSupported() bool {
+	return Colors() != ColorNone
 }
 
 // Colorize adds color to text if colors are supported
 func Colorize(text, color string) string {
-	if !ColorSupported() {
+	if !ColorSupported() || color == "" {
 		return text
 	}
 	return color + text + ColorReset
@@ -41,55 +136,64 @@ func Colorize(text, color string) string {
 
 // Red returns red colored text
 func Red(text string) string {
-	return Colorize(text, ColorRed)
+	return theme.Paint(text, theme.Red)
 }
 
 // Green returns green colored text
 func Green(text string) string {
-	return Colorize(text, ColorGreen)
+	return theme.Paint(text, theme.Green)
 }
 
 // Yellow returns yellow colored text
 func Yellow(text string) string {
-	return Colorize(text, ColorYellow)
+	return theme.Paint(text, theme.Yellow)
 }
 
 // Blue returns blue colored text
 func Blue(text string) string {
-	return Colorize(text, ColorBlue)
+	return theme.Paint(text, theme.Blue)
 }
 
 // Purple returns purple colored text
 func Purple(text string) string {
-	return Colorize(text, ColorPurple)
+	return theme.Paint(text, theme.Purple)
 }
 
 // Cyan returns cyan colored text
 func Cyan(text string) string {
-	return Colorize(text, ColorCyan)
+	return theme.Paint(text, theme.Cyan)
 }
 
 // Bold returns bold text
 func Bold(text string) string {
-	return Colorize(text, ColorBold)
+	return theme.Paint(text, theme.Bold)
 }
 
 // Success returns green colored success message
 func Success(text string) string {
-	return Green("✅ " + text)
+	return Green(decorate("✅", text))
 }
 
 // Error returns red colored error message
 func Error(text string) string {
-	return Red("❌ " + text)
+	return Red(decorate("❌", text))
 }
 
 // Warning returns yellow colored warning message
 func Warning(text string) string {
-	return Yellow("⚠️ " + text)
+	return Yellow(decorate("⚠️", text))
 }
 
 // Info returns blue colored info message
 func Info(text string) string {
-	return Blue("ℹ️ " + text)
+	return Blue(decorate("ℹ️", text))
+}
+
+// decorate puts an emoji before a message, unless emoji are left out for
+// screen readers
+func decorate(emoji, text string) string {
+	if i18n.PlainText() {
+		return text
+	}
+	return emoji + " " + text
 }
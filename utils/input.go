@@ -2,9 +2,13 @@ package utils
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/VinayBhutange/hangman-go/i18n"
 )
 
 const (
@@ -28,20 +32,20 @@ func GetUserInput(prompt string) (string, error) {
 
 // GetLetterInput gets a single letter input from the user
 func GetLetterInput() (rune, error) {
-	input, err := GetUserInput("Enter a letter: ")
+	input, err := GetUserInput(i18n.T("input.letter_prompt"))
 	if err != nil {
 		return 0, err
 	}
 
 	// Validate input
-	if len(input) != 1 {
-		return 0, fmt.Errorf("please enter exactly one letter")
+	if utf8.RuneCountInString(input) != 1 {
+		return 0, errors.New(i18n.T("input.exactly_one_letter"))
 	}
 
-	letter := rune(strings.ToUpper(input)[0])
+	letter, _ := utf8.DecodeRuneInString(strings.ToUpper(input))
 
 	if !IsLetter(letter) {
-		return 0, fmt.Errorf("please enter a valid letter (A-Z)")
+		return 0, errors.New(i18n.T("input.valid_letter", DescribeAlphabet()))
 	}
 
 	return letter, nil
@@ -49,38 +53,38 @@ func GetLetterInput() (rune, error) {
 
 // GetYesNoInput gets a yes/no response from the user
 func GetYesNoInput(prompt string) (bool, error) {
-	input, err := GetUserInput(prompt + " (y/n): ")
+	input, err := GetUserInput(prompt + " " + i18n.T("input.yes_no_hint"))
 	if err != nil {
 		return false, err
 	}
 
 	input = strings.ToLower(input)
 
-	switch input {
-	case "y", "yes":
+	// English answers are always understood alongside the translated ones
+	if input == "y" || input == "yes" || containsAnswer(i18n.T("input.yes_answers"), input) {
 		return true, nil
-	case "n", "no":
+	}
+	if input == "n" || input == "no" || containsAnswer(i18n.T("input.no_answers"), input) {
 		return false, nil
-	default:
-		return false, fmt.Errorf("please enter 'y' for yes or 'n' for no")
 	}
+	return false, errors.New(i18n.T("input.yes_no_error"))
 }
 
 // WaitForEnter waits for the user to press Enter
 func WaitForEnter() {
-	fmt.Print("Press Enter to continue...")
+	fmt.Print(i18n.T("input.press_enter"))
 	_, _ = bufio.NewReader(os.Stdin).ReadString('\n') //nolint:errcheck // Ignore error for continue prompt
 }
 
 // GetDifficultyInput gets difficulty level from user
 func GetDifficultyInput() (string, error) {
-	fmt.Println("Select difficulty level:")
-	fmt.Println("1. Easy (4-5 letters)")
-	fmt.Println("2. Medium (6-8 letters)")
-	fmt.Println("3. Hard (9+ letters)")
+	fmt.Println(i18n.T("difficulty.title"))
+	fmt.Println("1. " + i18n.T("difficulty.easy"))
+	fmt.Println("2. " + i18n.T("difficulty.medium"))
+	fmt.Println("3. " + i18n.T("difficulty.hard"))
 	fmt.Println()
 
-	input, err := GetUserInput("Enter your choice (1-3): ")
+	input, err := GetUserInput(i18n.T("menu.choice", 1, 3))
 	if err != nil {
 		return "", err
 	}
@@ -94,6 +98,16 @@ func GetDifficultyInput() (string, error) {
 	case "3":
 		return difficultyHard, nil
 	default:
-		return "", fmt.Errorf("please enter 1, 2, or 3")
+		return "", errors.New(i18n.T("difficulty.error"))
+	}
+}
+
+// containsAnswer reports whether input is one of the comma-separated answers
+func containsAnswer(answers, input string) bool {
+	for _, answer := range strings.Split(answers, ",") {
+		if strings.TrimSpace(answer) == input {
+			return true
+		}
 	}
+	return false
 }
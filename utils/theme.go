@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Color is one color of a theme, with the codes for each color level. Codes
+// left empty fall back to the next level down, so a color needs at least Basic.
+type Color struct {
+	Basic string // One of the 16 ANSI colors, or bold
+	X256  string // From the xterm 256-color palette
+	RGB   string // 24-bit color
+}
+
+// Theme maps the palette used by Red, Green and the other color helpers to
+// ANSI codes
+type Theme struct {
+	Name   string
+	Red    Color // Misses, errors and losses
+	Green  Color // Hits, success and wins
+	Yellow Color // Warnings
+	Blue   Color // Information
+	Purple Color
+	Cyan   Color // The word being guessed
+	Bold   Color // Headings
+}
+
+// DefaultTheme is the theme used until another one is chosen
+const DefaultTheme = "classic"
+
+// ErrUnknownTheme is returned for theme names that aren't built in
+var ErrUnknownTheme = errors.New("unknown color theme")
+
+// basic returns a color with only a 16-color code
+func basic(code string) Color {
+	return Color{Basic: code}
+}
+
+// rich returns a color with codes for every level: a 16-color fallback, an
+// index into the 256-color palette and a hex RGB value such as "#E69F00"
+func rich(fallback string, x256 int, rgb string) Color {
+	var r, g, b int
+	_, _ = fmt.Sscanf(rgb, "#%02x%02x%02x", &r, &g, &b) //nolint:errcheck // Values are built in
+	return Color{
+		Basic: fallback,
+		X256:  fmt.Sprintf("\033[38;5;%dm", x256),
+		RGB:   fmt.Sprintf("\033[38;2;%d;%d;%dm", r, g, b),
+	}
+}
+
+// Code returns the color's code for a color level, empty for ColorNone
+func (c Color) Code(level ColorLevel) string {
+	switch {
+	case level >= ColorTrue && c.RGB != "":
+		return c.RGB
+	case level >= Color256 && c.X256 != "":
+		return c.X256
+	case level >= Color16:
+		return c.Basic
+	default:
+		return ""
+	}
+}
+
+// themes are the built-in themes, in the order they are offered
+var themes = []Theme{
+	{
+		// The terminal's own palette
+		Name: DefaultTheme, Red: basic(ColorRed), Green: basic(ColorGreen), Yellow: basic(ColorYellow),
+		Blue: basic(ColorBlue), Purple: basic(ColorPurple), Cyan: basic(ColorCyan), Bold: basic(ColorBold),
+	},
+	{
+		Name: "bright", Red: basic("\033[91m"), Green: basic("\033[92m"), Yellow: basic("\033[93m"),
+		Blue: basic("\033[94m"), Purple: basic("\033[95m"), Cyan: basic("\033[96m"), Bold: basic(ColorBold),
+	},
+	{
+		// Orange and blue instead of red and green from the Okabe-Ito palette,
+		// told apart with red-green color blindness
+		Name: "colorblind", Red: rich(ColorYellow, 208, "#E69F00"), Green: rich(ColorBlue, 33, "#0072B2"),
+		Yellow: rich(ColorYellow, 226, "#F0E442"), Blue: rich(ColorCyan, 39, "#56B4E9"),
+		Purple: rich(ColorPurple, 175, "#CC79A7"), Cyan: rich(ColorCyan, 159, "#A6E1FA"), Bold: basic(ColorBold),
+	},
+	{
+		Name: "solarized", Red: rich(ColorRed, 160, "#DC322F"), Green: rich(ColorGreen, 64, "#859900"),
+		Yellow: rich(ColorYellow, 136, "#B58900"), Blue: rich(ColorBlue, 33, "#268BD2"),
+		Purple: rich(ColorPurple, 125, "#D33682"), Cyan: rich(ColorCyan, 37, "#2AA198"), Bold: basic(ColorBold),
+	},
+	{
+		// Only bold and plain text
+		Name: "mono", Red: basic(ColorBold), Green: basic(ColorBold), Bold: basic(ColorBold),
+	},
+}
+
+// theme is the theme in use
+var theme = themes[0]
+
+// Themes returns the names of the built-in themes
+func Themes() []string {
+	names := make([]string, len(themes))
+	for i, t := range themes {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// FindTheme returns the built-in theme with the given name, ignoring case
+func FindTheme(name string) (Theme, error) {
+	for _, t := range themes {
+		if strings.EqualFold(t.Name, name) {
+			return t, nil
+		}
+	}
+	return Theme{}, ErrUnknownTheme
+}
+
+// SetTheme switches the color helpers to a built-in theme. An empty name
+// selects DefaultTheme.
+func SetTheme(name string) error {
+	if name == "" {
+		name = DefaultTheme
+	}
+	t, err := FindTheme(name)
+	if err != nil {
+		return err
+	}
+	theme = t
+	return nil
+}
+
+// CurrentTheme returns the theme in use
+func CurrentTheme() Theme {
+	return theme
+}
+
+// Paint adds one of the theme's colors to text, as richly as the terminal's
+// color level allows. Colors the theme leaves empty give plain text.
+func (t Theme) Paint(text string, color Color) string {
+	code := color.Code(Colors())
+	if code == "" {
+		return text
+	}
+	return code + text + ColorReset
+}
@@ -105,3 +105,34 @@ func TestColorizeInternal(t *testing.T) {
 		t.Error("Green function should not return empty string")
 	}
 }
+
+func TestAlphabetInternal(t *testing.T) {
+	t.Cleanup(func() { SetAlphabet(nil) })
+
+	if DescribeAlphabet() != "A-Z" {
+		t.Errorf("Expected default alphabet A-Z, got %s", DescribeAlphabet())
+	}
+
+	SetAlphabet([]rune("ABCDEFGHIJKLMNÑOPQRSTUVWXYZ"))
+	if DescribeAlphabet() != "A-N, Ñ, O-Z" {
+		t.Errorf("Expected A-N, Ñ, O-Z, got %s", DescribeAlphabet())
+	}
+
+	if !IsLetter('ñ') || !IsLetter('Ñ') {
+		t.Error("Expected Ñ to be a letter of the Spanish alphabet")
+	}
+
+	letter, err := ValidateLetterGuess("ñ")
+	if err != nil || letter != 'Ñ' {
+		t.Errorf("Expected Ñ, got %c (%v)", letter, err)
+	}
+
+	SetAlphabet(nil)
+	if IsLetter('Ñ') {
+		t.Error("Did not expect Ñ to be a letter of the default alphabet")
+	}
+
+	if _, err := ValidateLetterGuess("é"); err == nil {
+		t.Error("Expected é to be rejected by the default alphabet")
+	}
+}
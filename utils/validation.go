@@ -2,10 +2,20 @@
 package utils
 
 import (
+	"fmt"
 	"strings"
 	"unicode"
+	"unicode/utf8"
+
+	"github.com/VinayBhutange/hangman-go/i18n"
 )
 
+// defaultAlphabet is the A-Z alphabet used until another one is set
+var defaultAlphabet = []rune("ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+
+// activeAlphabet holds the uppercase letters that are accepted as guesses
+var activeAlphabet = defaultAlphabet
+
 // ValidationError represents a validation error
 type ValidationError struct {
 	Message string
@@ -27,9 +37,51 @@ func IsValidationError(err error) bool {
 	return ok
 }
 
-// IsLetter checks if a rune is a letter (A-Z or a-z)
+// SetAlphabet sets the uppercase letters accepted as guesses; nil restores A-Z
+func SetAlphabet(alphabet []rune) {
+	if len(alphabet) == 0 {
+		activeAlphabet = defaultAlphabet
+		return
+	}
+	activeAlphabet = alphabet
+}
+
+// Alphabet returns the active alphabet
+func Alphabet() []rune {
+	return activeAlphabet
+}
+
+// DescribeAlphabet returns the active alphabet in short form, such as "A-N, Ñ, O-Z"
+func DescribeAlphabet() string {
+	var parts []string
+	for i := 0; i < len(activeAlphabet); {
+		// Collapse runs of consecutive letters into a range
+		j := i
+		for j+1 < len(activeAlphabet) && activeAlphabet[j+1] == activeAlphabet[j]+1 {
+			j++
+		}
+
+		if j-i >= 2 {
+			parts = append(parts, fmt.Sprintf("%c-%c", activeAlphabet[i], activeAlphabet[j]))
+		} else {
+			for k := i; k <= j; k++ {
+				parts = append(parts, string(activeAlphabet[k]))
+			}
+		}
+		i = j + 1
+	}
+	return strings.Join(parts, ", ")
+}
+
+// IsLetter checks if a rune is a letter of the active alphabet, ignoring case
 func IsLetter(r rune) bool {
-	return unicode.IsLetter(r)
+	r = unicode.ToUpper(r)
+	for _, letter := range activeAlphabet {
+		if letter == r {
+			return true
+		}
+	}
+	return false
 }
 
 // IsAlphabetic checks if a string contains only alphabetic characters
@@ -49,7 +101,7 @@ func IsAlphabetic(s string) bool {
 // IsValidWord checks if a word is valid for the game
 func IsValidWord(word string) bool {
 	// Word must be at least 3 characters
-	if len(word) < 3 {
+	if utf8.RuneCountInString(word) < 3 {
 		return false
 	}
 
@@ -84,22 +136,22 @@ func ContainsRune(slice []rune, target rune) bool {
 // ValidateLetterGuess validates user input for letter guessing
 func ValidateLetterGuess(input string) (rune, error) {
 	if input == "" {
-		return 0, NewValidationError("Please enter a letter")
+		return 0, NewValidationError(i18n.T("validation.empty"))
 	}
 
 	// Convert to uppercase and get first character
 	input = strings.ToUpper(strings.TrimSpace(input))
 
 	// Check if input is exactly one character
-	if len(input) != 1 {
-		return 0, NewValidationError("Please enter exactly one letter")
+	if utf8.RuneCountInString(input) != 1 {
+		return 0, NewValidationError(i18n.T("validation.exactly_one_letter"))
 	}
 
-	letter := rune(input[0])
+	letter, _ := utf8.DecodeRuneInString(input)
 
 	// Check if it's a letter
 	if !IsLetter(letter) {
-		return 0, NewValidationError("Please enter a valid letter (A-Z)")
+		return 0, NewValidationError(i18n.T("validation.valid_letter", DescribeAlphabet()))
 	}
 
 	return letter, nil